@@ -0,0 +1,124 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+)
+
+// cwMetricRow is a datapoint of the statistics of a resource metric, as
+// returned by the resource-specific metric tables
+type cwMetricRow struct {
+	// The dimension identifying the resource, e.g. InstanceId
+	DimensionValue string
+	Namespace      string
+	MetricName     string
+	*cloudwatch.Datapoint
+}
+
+// cwMetricColumns appends the statistic columns of the resource-specific
+// metric tables onto the column list
+func cwMetricColumns(columns []*plugin.Column) []*plugin.Column {
+	return awsRegionalColumns(append(columns, []*plugin.Column{
+		{
+			Name:        "metric_name",
+			Description: "The name of the metric.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "namespace",
+			Description: "The namespace of the metric.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "average",
+			Description: "The average of the metric values over the period.",
+			Type:        proto.ColumnType_DOUBLE,
+		},
+		{
+			Name:        "maximum",
+			Description: "The maximum metric value over the period.",
+			Type:        proto.ColumnType_DOUBLE,
+		},
+		{
+			Name:        "minimum",
+			Description: "The minimum metric value over the period.",
+			Type:        proto.ColumnType_DOUBLE,
+		},
+		{
+			Name:        "sample_count",
+			Description: "The number of metric values that contributed to the aggregate value of the datapoint.",
+			Type:        proto.ColumnType_DOUBLE,
+		},
+		{
+			Name:        "sum",
+			Description: "The sum of the metric values for the datapoint.",
+			Type:        proto.ColumnType_DOUBLE,
+		},
+		{
+			Name:        "unit",
+			Description: "The standard unit for the datapoint.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "timestamp",
+			Description: "The time stamp used for the datapoint.",
+			Type:        proto.ColumnType_TIMESTAMP,
+		},
+	}...))
+}
+
+// listCWMetricStatistics streams the daily statistics of a resource metric
+// over the last year, which is within the retention of hourly datapoints
+func listCWMetricStatistics(ctx context.Context, d *plugin.QueryData, namespace string, metricName string, dimensionName string, dimensionValue string) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+
+	// Create session
+	svc, err := CloudWatchService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	endTime := time.Now().Truncate(24 * time.Hour)
+	startTime := endTime.AddDate(-1, 0, 0)
+
+	params := &cloudwatch.GetMetricStatisticsInput{
+		Namespace:  aws.String(namespace),
+		MetricName: aws.String(metricName),
+		Dimensions: []*cloudwatch.Dimension{
+			{
+				Name:  aws.String(dimensionName),
+				Value: aws.String(dimensionValue),
+			},
+		},
+		Period:     aws.Int64(86400),
+		StartTime:  aws.Time(startTime),
+		EndTime:    aws.Time(endTime),
+		Statistics: aws.StringSlice(cloudwatch.Statistic_Values()),
+	}
+
+	stats, err := svc.GetMetricStatistics(params)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, datapoint := range stats.Datapoints {
+		d.StreamLeafListItem(ctx, &cwMetricRow{
+			DimensionValue: dimensionValue,
+			Namespace:      namespace,
+			MetricName:     metricName,
+			Datapoint:      datapoint,
+		})
+	}
+
+	return nil, nil
+}
@@ -11,6 +11,14 @@ type awsConfig struct {
 	AccessKey    *string  `cty:"access_key"`
 	SecretKey    *string  `cty:"secret_key"`
 	SessionToken *string  `cty:"session_token"`
+
+	ScanUserDataSecrets   *bool `cty:"scan_user_data_secrets"`
+	RedactUserDataSecrets *bool `cty:"redact_user_data_secrets"`
+
+	EBSVolumeMetricDays *int `cty:"ebs_volume_metric_days"`
+
+	RDSIncludeNeptuneAndDocDB *bool `cty:"rds_include_neptune_and_docdb"`
+	RDSDownloadLogFiles       *bool `cty:"rds_download_log_files"`
 }
 
 var ConfigSchema = map[string]*schema.Attribute{
@@ -30,6 +38,21 @@ var ConfigSchema = map[string]*schema.Attribute{
 	"session_token": {
 		Type: schema.TypeString,
 	},
+	"scan_user_data_secrets": {
+		Type: schema.TypeBool,
+	},
+	"redact_user_data_secrets": {
+		Type: schema.TypeBool,
+	},
+	"ebs_volume_metric_days": {
+		Type: schema.TypeInt,
+	},
+	"rds_include_neptune_and_docdb": {
+		Type: schema.TypeBool,
+	},
+	"rds_download_log_files": {
+		Type: schema.TypeBool,
+	},
 }
 
 func ConfigInstance() interface{} {
@@ -0,0 +1,84 @@
+package aws
+
+import (
+	"strings"
+)
+
+// policyRestrictingConditionKeys are the condition keys that limit who can
+// use a statement to known accounts, organizations or networks. A statement
+// with one of these is not public, even if its principal is "*".
+var policyRestrictingConditionKeys = map[string]bool{
+	"aws:principalaccount":  true,
+	"aws:principalarn":      true,
+	"aws:principalorgid":    true,
+	"aws:principalorgpaths": true,
+	"aws:sourceaccount":     true,
+	"aws:sourcearn":         true,
+	"aws:sourceip":          true,
+	"aws:sourceowner":       true,
+	"aws:sourcevpc":         true,
+	"aws:sourcevpce":        true,
+	"aws:userid":            true,
+}
+
+// policyPublicStatements returns the Allow statements of a canonical policy
+// that grant access to anyone, without a condition that restricts the caller.
+func policyPublicStatements(policy Policy) []Statement {
+	var public []Statement
+	for _, statement := range policy.Statements {
+		if statement.Effect != "Allow" {
+			continue
+		}
+		// NotPrincipal with Allow grants access to everyone else
+		if !policyPrincipalIsPublic(statement.Principal) && len(statement.NotPrincipal) == 0 {
+			continue
+		}
+		if policyConditionRestricts(statement.Condition) {
+			continue
+		}
+		public = append(public, statement)
+	}
+	return public
+}
+
+// policyPrincipalIsPublic reports whether a canonical principal includes
+// everyone, as "*" or {"AWS": "*"}
+func policyPrincipalIsPublic(principal Principal) bool {
+	for principalType, values := range principal {
+		if principalType != "AWS" && principalType != "*" {
+			continue
+		}
+		for _, value := range values.([]string) {
+			if value == "*" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// policyConditionRestricts reports whether a canonical condition limits the
+// statement to known callers. Negated and IfExists operators never restrict,
+// nor do values that match anything.
+func policyConditionRestricts(condition map[string]interface{}) bool {
+	for operator, keys := range condition {
+		if strings.Contains(operator, "Not") || strings.HasSuffix(operator, "IfExists") {
+			continue
+		}
+		for key, values := range keys.(map[string]interface{}) {
+			if !policyRestrictingConditionKeys[key] {
+				continue
+			}
+			restricts := true
+			for _, value := range values.([]string) {
+				if strings.Contains(value, "*") || value == "0.0.0.0/0" || value == "::/0" {
+					restricts = false
+				}
+			}
+			if restricts {
+				return true
+			}
+		}
+	}
+	return false
+}
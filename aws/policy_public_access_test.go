@@ -0,0 +1,74 @@
+package aws
+
+import (
+	"testing"
+)
+
+func TestPolicyPublicStatements(t *testing.T) {
+	policy := `{
+		"Version": "2012-10-17",
+		"Statement": [
+			{
+				"Sid": "PublicRead",
+				"Effect": "Allow",
+				"Principal": "*",
+				"Action": "s3:GetObject",
+				"Resource": "arn:aws:s3:::my-bucket/*"
+			},
+			{
+				"Sid": "VpcOnly",
+				"Effect": "Allow",
+				"Principal": {"AWS": "*"},
+				"Action": "s3:PutObject",
+				"Resource": "arn:aws:s3:::my-bucket/*",
+				"Condition": {"StringEquals": {"aws:SourceVpce": "vpce-1a2b3c4d"}}
+			},
+			{
+				"Sid": "AnyIp",
+				"Effect": "Allow",
+				"Principal": {"AWS": ["*"]},
+				"Action": "s3:ListBucket",
+				"Resource": "arn:aws:s3:::my-bucket",
+				"Condition": {"IpAddress": {"aws:SourceIp": "0.0.0.0/0"}}
+			},
+			{
+				"Sid": "NotOrg",
+				"Effect": "Allow",
+				"Principal": "*",
+				"Action": "s3:DeleteObject",
+				"Resource": "arn:aws:s3:::my-bucket/*",
+				"Condition": {"StringNotEquals": {"aws:PrincipalOrgID": "o-abc123"}}
+			},
+			{
+				"Sid": "Account",
+				"Effect": "Allow",
+				"Principal": {"AWS": "arn:aws:iam::123456789012:root"},
+				"Action": "s3:*",
+				"Resource": "arn:aws:s3:::my-bucket/*"
+			},
+			{
+				"Sid": "DenyAll",
+				"Effect": "Deny",
+				"Principal": "*",
+				"Action": "s3:*",
+				"Resource": "arn:aws:s3:::my-bucket/*"
+			}
+		]
+	}`
+
+	canonical, err := canonicalPolicy(policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"PublicRead", "AnyIp", "NotOrg"}
+	statements := policyPublicStatements(canonical.(Policy))
+	if len(statements) != len(expected) {
+		t.Fatalf("expected public statements %v, got %v", expected, statements)
+	}
+	for i, statement := range statements {
+		if statement.Sid != expected[i] {
+			t.Errorf("expected public statement %s, got %s", expected[i], statement.Sid)
+		}
+	}
+}
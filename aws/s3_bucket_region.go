@@ -0,0 +1,93 @@
+package aws
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+)
+
+// s3BucketRegionCacheTTL is how long the region of a bucket is cached. A
+// bucket can only move region by being deleted and recreated.
+const s3BucketRegionCacheTTL = time.Hour
+
+// s3BucketRegionLookups is the most GetBucketLocation calls made at once when
+// resolving the regions of many buckets
+const s3BucketRegionLookups = 20
+
+var (
+	// s3Clients are the S3 clients for each connection and region
+	s3Clients = newTTLCache(time.Hour)
+
+	// s3BucketRegions are the regions of the buckets of each connection
+	s3BucketRegions = newTTLCache(s3BucketRegionCacheTTL)
+)
+
+// getBucketRegion returns the region a bucket is in, from the cache if it has
+// been looked up recently
+func getBucketRegion(ctx context.Context, d *plugin.QueryData, bucket string) (string, error) {
+	cacheKey := pluginCacheKey(d, "s3BucketRegion-"+bucket)
+	if cachedData, ok := s3BucketRegions.Get(cacheKey); ok {
+		return cachedData.(string), nil
+	}
+
+	// Create Session
+	svc, err := S3Service(ctx, d, GetDefaultAwsRegion(d))
+	if err != nil {
+		return "", err
+	}
+
+	params := &s3.GetBucketLocationInput{
+		Bucket: aws.String(bucket),
+	}
+
+	// Specifies the Region where the bucket resides. For a list of all the Amazon
+	// S3 supported location constraints by Region, see Regions and Endpoints (https://docs.aws.amazon.com/general/latest/gr/rande.html#s3_region).
+	location, err := svc.GetBucketLocation(params)
+	if err != nil {
+		return "", err
+	}
+
+	// Buckets in Region us-east-1 have a LocationConstraint of null, and
+	// some old buckets in eu-west-1 have a LocationConstraint of EU
+	region := s3.NormalizeBucketLocation(aws.StringValue(location.LocationConstraint))
+
+	s3BucketRegions.Set(cacheKey, region)
+	return region, nil
+}
+
+// getBucketRegions looks up the regions of the buckets in parallel, returning
+// them in a map of bucket name to region
+func getBucketRegions(ctx context.Context, d *plugin.QueryData, buckets []*s3.Bucket) (map[string]string, error) {
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var lookupErr error
+	regions := map[string]string{}
+	lookups := make(chan struct{}, s3BucketRegionLookups)
+
+	for _, bucket := range buckets {
+		wg.Add(1)
+		lookups <- struct{}{}
+		go func(name string) {
+			defer wg.Done()
+			defer func() { <-lookups }()
+			region, err := getBucketRegion(ctx, d, name)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				lookupErr = err
+				return
+			}
+			regions[name] = region
+		}(*bucket.Name)
+	}
+	wg.Wait()
+
+	if lookupErr != nil {
+		return nil, lookupErr
+	}
+	return regions, nil
+}
@@ -0,0 +1,209 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+// cloudtrailEventDefaultWindow is how far back events are looked up when the
+// query gives neither a lookup attribute nor a start time, as reading the full
+// 90 days of every region at two requests per second can take hours
+const cloudtrailEventDefaultWindow = 24 * time.Hour
+
+//// TABLE DEFINITION
+
+func tableAwsCloudtrailEvent(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudtrail_event",
+		Description: "AWS CloudTrail Event",
+		List: &plugin.ListConfig{
+			Hydrate: listCloudtrailEvents,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "event_id",
+				Description: "The CloudTrail ID of the event.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "event_name",
+				Description: "The name of the event, such as the API action that was called.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "event_source",
+				Description: "The AWS service that the request was made to, such as iam.amazonaws.com.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "event_time",
+				Description: "The date and time of the event. Only the last 24 hours are looked up unless the query gives a lookup column or a lower bound on event_time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "username",
+				Description: "The user name or role name of the requester that made the call.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "access_key_id",
+				Description: "The AWS access key ID that was used to sign the request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "read_only",
+				Description: "Whether the event is a read-only event.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("ReadOnly").Transform(transform.ToBool),
+			},
+			{
+				Name:        "resources",
+				Description: "A list of resources referenced by the event.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "resource_name",
+				Description: "Filters the results to events that reference a resource with this name. Only set when given in the query.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_type",
+				Description: "Filters the results to events that reference a resource of this type. Only set when given in the query.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cloud_trail_event",
+				Description: "The full CloudTrail record of the event.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CloudTrailEvent").Transform(transform.UnmarshalYAML),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EventId"),
+			},
+		}),
+	}
+}
+
+// cloudtrailEventInfo is an event, along with the resource filters used to
+// find it so they match the quals
+type cloudtrailEventInfo struct {
+	ResourceName *string
+	ResourceType *string
+	*cloudtrail.Event
+}
+
+// cloudtrailEventLookupColumns maps the columns that can be used to look up
+// events to their lookup attribute keys, in order of preference. LookupEvents
+// only accepts a single lookup attribute.
+var cloudtrailEventLookupColumns = []struct {
+	column string
+	key    string
+}{
+	{"event_id", cloudtrail.LookupAttributeKeyEventId},
+	{"access_key_id", cloudtrail.LookupAttributeKeyAccessKeyId},
+	{"resource_name", cloudtrail.LookupAttributeKeyResourceName},
+	{"event_name", cloudtrail.LookupAttributeKeyEventName},
+	{"username", cloudtrail.LookupAttributeKeyUsername},
+	{"event_source", cloudtrail.LookupAttributeKeyEventSource},
+	{"resource_type", cloudtrail.LookupAttributeKeyResourceType},
+}
+
+//// LIST FUNCTION
+
+func listCloudtrailEvents(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	plugin.Logger(ctx).Trace("listCloudtrailEvents", "AWS_REGION", region)
+
+	// Create session
+	svc, err := CloudTrailService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &cloudtrail.LookupEventsInput{}
+	var lookupColumn string
+	for _, lookup := range cloudtrailEventLookupColumns {
+		if value := getQualsStringValue(d, lookup.column); value != nil {
+			lookupColumn = lookup.column
+			input.LookupAttributes = []*cloudtrail.LookupAttribute{
+				{
+					AttributeKey:   aws.String(lookup.key),
+					AttributeValue: value,
+				},
+			}
+			break
+		}
+	}
+	input.StartTime, input.EndTime = getQualsTimeRange(d, "event_time", time.Second)
+	if input.StartTime == nil && lookupColumn == "" {
+		end := time.Now()
+		if input.EndTime != nil {
+			end = *input.EndTime
+		}
+		input.StartTime = aws.Time(end.Add(-cloudtrailEventDefaultWindow))
+	}
+
+	// The resource filters are echoed back in each row, so events that were
+	// not looked up by them must be checked against their resources instead
+	resourceName := getQualsStringValue(d, "resource_name")
+	resourceType := getQualsStringValue(d, "resource_type")
+	checkName, checkType := resourceName, resourceType
+	if lookupColumn == "resource_name" {
+		checkName = nil
+	} else if lookupColumn == "resource_type" {
+		checkType = nil
+	}
+
+	// List call
+	err = svc.LookupEventsPages(
+		input,
+		func(page *cloudtrail.LookupEventsOutput, isLast bool) bool {
+			for _, event := range page.Events {
+				if !cloudtrailEventHasResource(event, checkName, checkType) {
+					continue
+				}
+				d.StreamListItem(ctx, &cloudtrailEventInfo{
+					ResourceName: resourceName,
+					ResourceType: resourceType,
+					Event:        event,
+				})
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
+
+// cloudtrailEventHasResource reports whether the event references a resource
+// with the given name and type. A nil name or type matches any resource.
+func cloudtrailEventHasResource(event *cloudtrail.Event, name *string, resourceType *string) bool {
+	if name == nil && resourceType == nil {
+		return true
+	}
+	for _, resource := range event.Resources {
+		if name != nil && aws.StringValue(resource.ResourceName) != *name {
+			continue
+		}
+		if resourceType != nil && aws.StringValue(resource.ResourceType) != *resourceType {
+			continue
+		}
+		return true
+	}
+	return false
+}
@@ -0,0 +1,425 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCloudtrailTrail(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudtrail_trail",
+		Description: "AWS CloudTrail Trail",
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("name"),
+			ShouldIgnoreError: isNotFoundError([]string{"TrailNotFoundException", "InvalidTrailNameException"}),
+			Hydrate:           getCloudtrailTrail,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCloudtrailTrails,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the trail.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the trail.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TrailARN"),
+			},
+			{
+				Name:        "home_region",
+				Description: "The region in which the trail was created.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_multi_region_trail",
+				Description: "Specifies whether the trail exists only in one region or exists in all regions.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "is_organization_trail",
+				Description: "Specifies whether the trail is an organization trail.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "include_global_service_events",
+				Description: "Specifies whether events from global services such as IAM are included in the logs.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "log_file_validation_enabled",
+				Description: "Specifies whether log file validation is enabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "has_custom_event_selectors",
+				Description: "Specifies whether the trail has custom event selectors.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "has_insight_selectors",
+				Description: "Specifies whether the trail has insight selectors.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "s3_bucket_name",
+				Description: "The name of the Amazon S3 bucket into which CloudTrail delivers the trail files.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "s3_key_prefix",
+				Description: "The Amazon S3 key prefix that comes after the name of the bucket you have designated for log file delivery.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "sns_topic_arn",
+				Description: "The ARN of the Amazon SNS topic that CloudTrail uses to send notifications when log files are delivered.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SnsTopicARN"),
+			},
+			{
+				Name:        "cloudwatch_logs_log_group_arn",
+				Description: "The ARN of the CloudWatch Logs log group to which CloudTrail logs will be delivered.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CloudWatchLogsLogGroupArn"),
+			},
+			{
+				Name:        "cloudwatch_logs_role_arn",
+				Description: "The role for the CloudWatch Logs endpoint to assume to write to a user's log group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CloudWatchLogsRoleArn"),
+			},
+			{
+				Name:        "kms_key_id",
+				Description: "The KMS key ID that encrypts the logs delivered by CloudTrail.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_logging",
+				Description: "Whether the trail is currently logging AWS API calls.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getCloudtrailTrailStatus,
+			},
+			{
+				Name:        "latest_delivery_error",
+				Description: "The error that CloudTrail encountered when it last tried to deliver log files to the S3 bucket.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloudtrailTrailStatus,
+			},
+			{
+				Name:        "latest_delivery_time",
+				Description: "The date and time that CloudTrail last delivered log files to the S3 bucket.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getCloudtrailTrailStatus,
+			},
+			{
+				Name:        "latest_notification_error",
+				Description: "The error that CloudTrail encountered when it last tried to send an SNS notification.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloudtrailTrailStatus,
+			},
+			{
+				Name:        "latest_notification_time",
+				Description: "The date and time of the most recent SNS notification that CloudTrail has written.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getCloudtrailTrailStatus,
+			},
+			{
+				Name:        "latest_cloudwatch_logs_delivery_error",
+				Description: "The error that CloudTrail encountered when it last tried to deliver logs to CloudWatch Logs.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloudtrailTrailStatus,
+				Transform:   transform.FromField("LatestCloudWatchLogsDeliveryError"),
+			},
+			{
+				Name:        "latest_cloudwatch_logs_delivery_time",
+				Description: "The date and time that CloudTrail last delivered logs to CloudWatch Logs.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getCloudtrailTrailStatus,
+				Transform:   transform.FromField("LatestCloudWatchLogsDeliveryTime"),
+			},
+			{
+				Name:        "latest_digest_delivery_error",
+				Description: "The error that CloudTrail encountered when it last tried to deliver a digest file to the S3 bucket.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloudtrailTrailStatus,
+			},
+			{
+				Name:        "latest_digest_delivery_time",
+				Description: "The date and time that CloudTrail last delivered a digest file to the S3 bucket.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getCloudtrailTrailStatus,
+			},
+			{
+				Name:        "start_logging_time",
+				Description: "The date and time of the most recent call to StartLogging for the trail.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getCloudtrailTrailStatus,
+			},
+			{
+				Name:        "stop_logging_time",
+				Description: "The date and time of the most recent call to StopLogging for the trail.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getCloudtrailTrailStatus,
+			},
+			{
+				Name:        "event_selectors",
+				Description: "The basic event selectors of the trail, which specify the management and data events logged.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudtrailTrailEventSelectors,
+			},
+			{
+				Name:        "advanced_event_selectors",
+				Description: "The advanced event selectors of the trail, if it uses them in place of basic event selectors.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudtrailTrailEventSelectors,
+			},
+			{
+				Name:        "insight_selectors",
+				Description: "The types of CloudTrail Insights events logged by the trail.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudtrailTrailInsightSelectors,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the trail.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudtrailTrailTags,
+				Transform:   transform.FromValue(),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudtrailTrailTags,
+				Transform:   transform.FromValue().Transform(cloudtrailTrailTurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("TrailARN").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudtrailTrails(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	plugin.Logger(ctx).Trace("listCloudtrailTrails", "AWS_REGION", region)
+
+	// Create session
+	svc, err := CloudTrailService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// Multi-region trails are returned in every region unless shadow trails
+	// are excluded, so only list the trails whose home region this is
+	resp, err := svc.DescribeTrails(&cloudtrail.DescribeTrailsInput{
+		IncludeShadowTrails: aws.Bool(false),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, trail := range resp.TrailList {
+		d.StreamListItem(ctx, trail)
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCloudtrailTrail(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getCloudtrailTrail")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	// Create session
+	svc, err := CloudTrailService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &cloudtrail.DescribeTrailsInput{
+		TrailNameList:       []*string{aws.String(name)},
+		IncludeShadowTrails: aws.Bool(false),
+	}
+
+	op, err := svc.DescribeTrails(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(op.TrailList) > 0 {
+		return op.TrailList[0], nil
+	}
+	return nil, nil
+}
+
+func getCloudtrailTrailStatus(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getCloudtrailTrailStatus")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	trail := h.Item.(*cloudtrail.Trail)
+
+	// Create session
+	svc, err := CloudTrailService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &cloudtrail.GetTrailStatusInput{
+		Name: trail.TrailARN,
+	}
+
+	status, err := svc.GetTrailStatus(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+func getCloudtrailTrailEventSelectors(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getCloudtrailTrailEventSelectors")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	trail := h.Item.(*cloudtrail.Trail)
+
+	// Create session
+	svc, err := CloudTrailService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &cloudtrail.GetEventSelectorsInput{
+		TrailName: trail.TrailARN,
+	}
+
+	selectors, err := svc.GetEventSelectors(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return selectors, nil
+}
+
+func getCloudtrailTrailInsightSelectors(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getCloudtrailTrailInsightSelectors")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	trail := h.Item.(*cloudtrail.Trail)
+
+	// Create session
+	svc, err := CloudTrailService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &cloudtrail.GetInsightSelectorsInput{
+		TrailName: trail.TrailARN,
+	}
+
+	selectors, err := svc.GetInsightSelectors(params)
+	if err != nil {
+		// Returned for trails that have never had Insights enabled
+		if a, ok := err.(awserr.Error); ok && a.Code() == cloudtrail.ErrCodeInsightNotEnabledException {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return selectors, nil
+}
+
+func getCloudtrailTrailTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getCloudtrailTrailTags")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	trail := h.Item.(*cloudtrail.Trail)
+
+	// Create session
+	svc, err := CloudTrailService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &cloudtrail.ListTagsInput{
+		ResourceIdList: []*string{trail.TrailARN},
+	}
+
+	op, err := svc.ListTags(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(op.ResourceTagList) > 0 {
+		return op.ResourceTagList[0].TagsList, nil
+	}
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func cloudtrailTrailTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags, ok := d.Value.([]*cloudtrail.Tag)
+	if !ok || len(tags) == 0 {
+		return nil, nil
+	}
+
+	turbotTagsMap := map[string]string{}
+	for _, tag := range tags {
+		turbotTagsMap[*tag.Key] = *tag.Value
+	}
+	return turbotTagsMap, nil
+}
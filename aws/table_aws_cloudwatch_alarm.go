@@ -0,0 +1,396 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCloudwatchAlarm(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudwatch_alarm",
+		Description: "AWS CloudWatch Alarm",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getCloudwatchAlarm,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCloudwatchAlarms,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the alarm.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AlarmName"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the alarm.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AlarmArn"),
+			},
+			{
+				Name:        "alarm_type",
+				Description: "The type of the alarm (MetricAlarm | CompositeAlarm).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state_value",
+				Description: "The state value for the alarm (OK | ALARM | INSUFFICIENT_DATA).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state_reason",
+				Description: "An explanation for the alarm state, in text format.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state_reason_data",
+				Description: "An explanation for the alarm state, in JSON format.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("StateReasonData").Transform(transform.UnmarshalYAML),
+			},
+			{
+				Name:        "state_updated_timestamp",
+				Description: "The time stamp of the last update to the alarm state.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "alarm_description",
+				Description: "The description of the alarm.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "alarm_configuration_updated_timestamp",
+				Description: "The time stamp of the last update to the alarm configuration.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "actions_enabled",
+				Description: "Indicates whether actions should be executed during any changes to the alarm state.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "alarm_actions",
+				Description: "The actions to execute when this alarm transitions to the ALARM state from any other state. Each action is specified as an Amazon Resource Name (ARN).",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "ok_actions",
+				Description: "The actions to execute when this alarm transitions to the OK state from any other state. Each action is specified as an Amazon Resource Name (ARN).",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("OKActions"),
+			},
+			{
+				Name:        "insufficient_data_actions",
+				Description: "The actions to execute when this alarm transitions to the INSUFFICIENT_DATA state from any other state. Each action is specified as an Amazon Resource Name (ARN).",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "metric_name",
+				Description: "The name of the metric associated with a metric alarm, if the alarm is based on a single metric.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MetricAlarm.MetricName"),
+			},
+			{
+				Name:        "namespace",
+				Description: "The namespace of the metric associated with a metric alarm, if the alarm is based on a single metric.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MetricAlarm.Namespace"),
+			},
+			{
+				Name:        "dimensions",
+				Description: "The dimensions of the metric associated with a metric alarm.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("MetricAlarm.Dimensions"),
+			},
+			{
+				Name:        "statistic",
+				Description: "The statistic for the metric associated with a metric alarm, other than percentile.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MetricAlarm.Statistic"),
+			},
+			{
+				Name:        "extended_statistic",
+				Description: "The percentile statistic for the metric associated with a metric alarm.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MetricAlarm.ExtendedStatistic"),
+			},
+			{
+				Name:        "period",
+				Description: "The period, in seconds, over which the statistic is applied.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("MetricAlarm.Period"),
+			},
+			{
+				Name:        "evaluation_periods",
+				Description: "The number of periods over which data is compared to the specified threshold.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("MetricAlarm.EvaluationPeriods"),
+			},
+			{
+				Name:        "datapoints_to_alarm",
+				Description: "The number of data points that must be breaching to trigger the alarm.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("MetricAlarm.DatapointsToAlarm"),
+			},
+			{
+				Name:        "threshold",
+				Description: "The value to compare with the specified statistic.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("MetricAlarm.Threshold"),
+			},
+			{
+				Name:        "threshold_metric_id",
+				Description: "The ID of the anomaly detection band used as the threshold, if the alarm is based on anomaly detection.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MetricAlarm.ThresholdMetricId"),
+			},
+			{
+				Name:        "comparison_operator",
+				Description: "The arithmetic operation to use when comparing the specified statistic and threshold.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MetricAlarm.ComparisonOperator"),
+			},
+			{
+				Name:        "treat_missing_data",
+				Description: "Sets how this alarm is to handle missing data points (breaching | notBreaching | ignore | missing).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MetricAlarm.TreatMissingData"),
+			},
+			{
+				Name:        "evaluate_low_sample_count_percentile",
+				Description: "Used only for alarms based on percentiles. If ignore, the alarm state does not change during periods with too few data points to be statistically significant.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MetricAlarm.EvaluateLowSampleCountPercentile"),
+			},
+			{
+				Name:        "unit",
+				Description: "The unit of the metric associated with a metric alarm.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MetricAlarm.Unit"),
+			},
+			{
+				Name:        "metrics",
+				Description: "The metric queries of a metric alarm based on a metric math expression or anomaly detection model.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("MetricAlarm.Metrics"),
+			},
+			{
+				Name:        "alarm_rule",
+				Description: "The rule that a composite alarm evaluates to determine its state.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the alarm.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudwatchAlarmTags,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudwatchAlarmTags,
+				Transform:   transform.FromField("Tags").Transform(cloudwatchAlarmTurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AlarmName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AlarmArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+// cloudwatchAlarmInfo holds the fields common to metric and composite alarms,
+// along with the metric alarm or the rule of the composite alarm
+type cloudwatchAlarmInfo struct {
+	AlarmType                          string
+	AlarmName                          *string
+	AlarmArn                           *string
+	AlarmDescription                   *string
+	AlarmConfigurationUpdatedTimestamp *time.Time
+	StateValue                         *string
+	StateReason                        *string
+	StateReasonData                    *string
+	StateUpdatedTimestamp              *time.Time
+	ActionsEnabled                     *bool
+	AlarmActions                       []*string
+	OKActions                          []*string
+	InsufficientDataActions            []*string
+	MetricAlarm                        *cloudwatch.MetricAlarm
+	AlarmRule                          *string
+}
+
+//// LIST FUNCTION
+
+func listCloudwatchAlarms(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	plugin.Logger(ctx).Trace("listCloudwatchAlarms", "AWS_REGION", region)
+
+	// Create session
+	svc, err := CloudWatchService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// List call
+	err = svc.DescribeAlarmsPages(
+		&cloudwatch.DescribeAlarmsInput{
+			AlarmTypes: aws.StringSlice(cloudwatch.AlarmType_Values()),
+		},
+		func(page *cloudwatch.DescribeAlarmsOutput, isLast bool) bool {
+			for _, alarm := range cloudwatchAlarms(page) {
+				d.StreamListItem(ctx, alarm)
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCloudwatchAlarm(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getCloudwatchAlarm")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	// Create session
+	svc, err := CloudWatchService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &cloudwatch.DescribeAlarmsInput{
+		AlarmNames: []*string{aws.String(name)},
+		AlarmTypes: aws.StringSlice(cloudwatch.AlarmType_Values()),
+	}
+
+	op, err := svc.DescribeAlarms(params)
+	if err != nil {
+		return nil, err
+	}
+
+	alarms := cloudwatchAlarms(op)
+	if len(alarms) > 0 {
+		return alarms[0], nil
+	}
+	return nil, nil
+}
+
+func getCloudwatchAlarmTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getCloudwatchAlarmTags")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	alarm := h.Item.(*cloudwatchAlarmInfo)
+
+	// Create session
+	svc, err := CloudWatchService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &cloudwatch.ListTagsForResourceInput{
+		ResourceARN: alarm.AlarmArn,
+	}
+
+	op, err := svc.ListTagsForResource(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+// cloudwatchAlarms returns the metric and composite alarms of a DescribeAlarms page
+func cloudwatchAlarms(page *cloudwatch.DescribeAlarmsOutput) []*cloudwatchAlarmInfo {
+	var alarms []*cloudwatchAlarmInfo
+	for _, alarm := range page.MetricAlarms {
+		alarms = append(alarms, &cloudwatchAlarmInfo{
+			AlarmType:                          cloudwatch.AlarmTypeMetricAlarm,
+			AlarmName:                          alarm.AlarmName,
+			AlarmArn:                           alarm.AlarmArn,
+			AlarmDescription:                   alarm.AlarmDescription,
+			AlarmConfigurationUpdatedTimestamp: alarm.AlarmConfigurationUpdatedTimestamp,
+			StateValue:                         alarm.StateValue,
+			StateReason:                        alarm.StateReason,
+			StateReasonData:                    alarm.StateReasonData,
+			StateUpdatedTimestamp:              alarm.StateUpdatedTimestamp,
+			ActionsEnabled:                     alarm.ActionsEnabled,
+			AlarmActions:                       alarm.AlarmActions,
+			OKActions:                          alarm.OKActions,
+			InsufficientDataActions:            alarm.InsufficientDataActions,
+			MetricAlarm:                        alarm,
+		})
+	}
+	for _, alarm := range page.CompositeAlarms {
+		alarms = append(alarms, &cloudwatchAlarmInfo{
+			AlarmType:                          cloudwatch.AlarmTypeCompositeAlarm,
+			AlarmName:                          alarm.AlarmName,
+			AlarmArn:                           alarm.AlarmArn,
+			AlarmDescription:                   alarm.AlarmDescription,
+			AlarmConfigurationUpdatedTimestamp: alarm.AlarmConfigurationUpdatedTimestamp,
+			StateValue:                         alarm.StateValue,
+			StateReason:                        alarm.StateReason,
+			StateReasonData:                    alarm.StateReasonData,
+			StateUpdatedTimestamp:              alarm.StateUpdatedTimestamp,
+			ActionsEnabled:                     alarm.ActionsEnabled,
+			AlarmActions:                       alarm.AlarmActions,
+			OKActions:                          alarm.OKActions,
+			InsufficientDataActions:            alarm.InsufficientDataActions,
+			AlarmRule:                          alarm.AlarmRule,
+		})
+	}
+	return alarms
+}
+
+//// TRANSFORM FUNCTIONS
+
+func cloudwatchAlarmTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags, ok := d.Value.([]*cloudwatch.Tag)
+	if !ok || len(tags) == 0 {
+		return nil, nil
+	}
+
+	turbotTagsMap := map[string]string{}
+	for _, tag := range tags {
+		turbotTagsMap[*tag.Key] = *tag.Value
+	}
+	return turbotTagsMap, nil
+}
@@ -0,0 +1,104 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCloudwatchAlarmHistory(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudwatch_alarm_history",
+		Description: "AWS CloudWatch Alarm History",
+		List: &plugin.ListConfig{
+			Hydrate: listCloudwatchAlarmHistory,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "alarm_name",
+				Description: "The descriptive name for the alarm.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "alarm_type",
+				Description: "The type of alarm (MetricAlarm | CompositeAlarm).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "history_item_type",
+				Description: "The type of alarm history item (ConfigurationUpdate | StateUpdate | Action).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "timestamp",
+				Description: "The time stamp for the alarm history item.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "history_summary",
+				Description: "A summary of the alarm history, in text format.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "history_data",
+				Description: "Data about the alarm, in JSON format.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("HistoryData").Transform(transform.UnmarshalYAML),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HistorySummary"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudwatchAlarmHistory(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	plugin.Logger(ctx).Trace("listCloudwatchAlarmHistory", "AWS_REGION", region)
+
+	// Create session
+	svc, err := CloudWatchService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// History is kept for 30 days, but can still be large, so push down any
+	// filters given in the query
+	input := &cloudwatch.DescribeAlarmHistoryInput{
+		AlarmName:       getQualsStringValue(d, "alarm_name"),
+		HistoryItemType: getQualsStringValue(d, "history_item_type"),
+		AlarmTypes:      aws.StringSlice(cloudwatch.AlarmType_Values()),
+	}
+	input.StartDate, input.EndDate = getQualsTimeRange(d, "timestamp", time.Millisecond)
+
+	// List call
+	err = svc.DescribeAlarmHistoryPages(
+		input,
+		func(page *cloudwatch.DescribeAlarmHistoryOutput, isLast bool) bool {
+			for _, item := range page.AlarmHistoryItems {
+				d.StreamListItem(ctx, item)
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
@@ -0,0 +1,183 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+// maxCloudwatchLogEventRows is the most events listed for a single query, so
+// that a query without a narrow filter can not read an entire log group
+const maxCloudwatchLogEventRows = 10000
+
+//// TABLE DEFINITION
+
+func tableAwsCloudwatchLogEvent(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudwatch_log_event",
+		Description: "AWS CloudWatch Log Event",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.SingleColumn("log_group_name"),
+			Hydrate:    listCloudwatchLogEvents,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "log_group_name",
+				Description: "The name of the log group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "log_stream_name",
+				Description: "The name of the log stream the event belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "event_id",
+				Description: "The ID of the event.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "timestamp",
+				Description: "The time the event occurred.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Timestamp").Transform(convertTimestamp),
+			},
+			{
+				Name:        "ingestion_time",
+				Description: "The time the event was ingested.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("IngestionTime").Transform(convertTimestamp),
+			},
+			{
+				Name:        "message",
+				Description: "The data contained in the log event.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "message_json",
+				Description: "The data contained in the log event, if it is a JSON object.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Message").Transform(logEventMessageJSON),
+			},
+			{
+				Name:        "log_stream_name_prefix",
+				Description: "Filters the results to events in log streams whose names begin with this prefix. Only set when given in the query.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "filter",
+				Description: "The filter pattern the events must match. Only set when given in the query.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EventId"),
+			},
+		}),
+	}
+}
+
+// cloudwatchLogEventInfo is a log event, along with the filters used to find
+// it so they match the quals
+type cloudwatchLogEventInfo struct {
+	LogGroupName        string
+	LogStreamNamePrefix *string
+	Filter              *string
+	*cloudwatchlogs.FilteredLogEvent
+}
+
+//// LIST FUNCTION
+
+func listCloudwatchLogEvents(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	plugin.Logger(ctx).Trace("listCloudwatchLogEvents", "AWS_REGION", region)
+
+	// Create session
+	svc, err := CloudWatchLogsService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	logGroupName := d.KeyColumnQuals["log_group_name"].GetStringValue()
+	logStreamNamePrefix := getQualsStringValue(d, "log_stream_name_prefix")
+	input := &cloudwatchlogs.FilterLogEventsInput{
+		LogGroupName:  aws.String(logGroupName),
+		FilterPattern: getQualsStringValue(d, "filter"),
+	}
+	// FilterLogEvents rejects a stream name and a prefix together, and the name
+	// is the narrower of the two
+	if logStreamName := getQualsStringValue(d, "log_stream_name"); logStreamName != nil {
+		if logStreamNamePrefix != nil && !strings.HasPrefix(*logStreamName, *logStreamNamePrefix) {
+			return nil, nil
+		}
+		input.LogStreamNames = []*string{logStreamName}
+	} else {
+		input.LogStreamNamePrefix = logStreamNamePrefix
+	}
+	startTime, endTime := getQualsTimeRange(d, "timestamp", time.Millisecond)
+	if startTime != nil {
+		input.StartTime = aws.Int64(startTime.UnixNano() / 1e6)
+	}
+	if endTime != nil {
+		input.EndTime = aws.Int64(endTime.UnixNano() / 1e6)
+	}
+
+	// List call
+	rows := 0
+	err = svc.FilterLogEventsPages(
+		input,
+		func(page *cloudwatchlogs.FilterLogEventsOutput, isLast bool) bool {
+			for _, event := range page.Events {
+				rows++
+				d.StreamListItem(ctx, &cloudwatchLogEventInfo{
+					LogGroupName:        logGroupName,
+					LogStreamNamePrefix: logStreamNamePrefix,
+					Filter:              input.FilterPattern,
+					FilteredLogEvent:    event,
+				})
+			}
+			if rows >= maxCloudwatchLogEventRows {
+				plugin.Logger(ctx).Warn("listCloudwatchLogEvents", "log_group_name", logGroupName, "row limit reached", maxCloudwatchLogEventRows)
+				return false
+			}
+			return !isLast
+		},
+	)
+	if err != nil {
+		// The log group may have been deleted, or never existed
+		if a, ok := err.(awserr.Error); ok && a.Code() == cloudwatchlogs.ErrCodeResourceNotFoundException {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func logEventMessageJSON(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	message := types.SafeString(d.Value)
+	var value map[string]interface{}
+	if err := json.Unmarshal([]byte(message), &value); err != nil {
+		return nil, nil
+	}
+	return value, nil
+}
@@ -0,0 +1,170 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+// maxCloudwatchLogInsightsQueryWait is how long a query may be scheduled or
+// running before it is stopped
+const maxCloudwatchLogInsightsQueryWait = 5 * time.Minute
+
+//// TABLE DEFINITION
+
+func tableAwsCloudwatchLogInsightsQuery(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudwatch_log_insights_query",
+		Description: "AWS CloudWatch Logs Insights Query",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.AllColumns([]string{"log_group_name", "query"}),
+			Hydrate:    listCloudwatchLogInsightsQueryResults,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "log_group_name",
+				Description: "The name of the log group to query.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "query",
+				Description: "The Logs Insights query string.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "start_time",
+				Description: "The beginning of the time range to query, given with =. Defaults to one hour before end_time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "end_time",
+				Description: "The end of the time range to query, given with =. Defaults to the current time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "query_id",
+				Description: "The unique ID of the query.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "fields",
+				Description: "The fields of the result row, as a map of field name to value.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("QueryId"),
+			},
+		}),
+	}
+}
+
+// cloudwatchLogInsightsQueryResult is a result row of a query, along with the
+// query parameters so they match the quals
+type cloudwatchLogInsightsQueryResult struct {
+	LogGroupName string
+	Query        string
+	StartTime    time.Time
+	EndTime      time.Time
+	QueryId      *string
+	Fields       map[string]string
+}
+
+//// LIST FUNCTION
+
+func listCloudwatchLogInsightsQueryResults(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	plugin.Logger(ctx).Trace("listCloudwatchLogInsightsQueryResults", "AWS_REGION", region)
+
+	// Create session
+	svc, err := CloudWatchLogsService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	quals := d.KeyColumnQuals
+	logGroupName := quals["log_group_name"].GetStringValue()
+	query := quals["query"].GetStringValue()
+
+	// Each row carries the time range, so only = quals are used, letting the
+	// rows match them when Postgres checks the quals again
+	endTime := time.Now()
+	if end := getQualsTimeValue(d, "end_time"); end != nil {
+		endTime = *end
+	}
+	startTime := endTime.Add(-time.Hour)
+	if start := getQualsTimeValue(d, "start_time"); start != nil {
+		startTime = *start
+	}
+
+	op, err := svc.StartQueryWithContext(ctx, &cloudwatchlogs.StartQueryInput{
+		LogGroupName: aws.String(logGroupName),
+		QueryString:  aws.String(query),
+		StartTime:    aws.Int64(startTime.Unix()),
+		EndTime:      aws.Int64(endTime.Unix()),
+	})
+	if err != nil {
+		// The log group may have been deleted, or never existed
+		if a, ok := err.(awserr.Error); ok && a.Code() == cloudwatchlogs.ErrCodeResourceNotFoundException {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// Poll until the query has finished, stopping it if it takes too long
+	var results *cloudwatchlogs.GetQueryResultsOutput
+	deadline := time.Now().Add(maxCloudwatchLogInsightsQueryWait)
+	for {
+		results, err = svc.GetQueryResultsWithContext(ctx, &cloudwatchlogs.GetQueryResultsInput{
+			QueryId: op.QueryId,
+		})
+		if err != nil {
+			return nil, err
+		}
+		status := types.SafeString(results.Status)
+		if status == cloudwatchlogs.QueryStatusComplete {
+			break
+		}
+		if status != cloudwatchlogs.QueryStatusScheduled && status != cloudwatchlogs.QueryStatusRunning {
+			return nil, fmt.Errorf("query %s did not complete: %s", *op.QueryId, status)
+		}
+		if time.Now().After(deadline) {
+			_, _ = svc.StopQuery(&cloudwatchlogs.StopQueryInput{QueryId: op.QueryId})
+			return nil, fmt.Errorf("query %s did not complete within %s", *op.QueryId, maxCloudwatchLogInsightsQueryWait)
+		}
+		time.Sleep(time.Second)
+	}
+
+	for _, result := range results.Results {
+		fields := map[string]string{}
+		for _, field := range result {
+			fields[types.SafeString(field.Field)] = types.SafeString(field.Value)
+		}
+		d.StreamListItem(ctx, &cloudwatchLogInsightsQueryResult{
+			LogGroupName: logGroupName,
+			Query:        query,
+			StartTime:    startTime,
+			EndTime:      endTime,
+			QueryId:      op.QueryId,
+			Fields:       fields,
+		})
+	}
+
+	return nil, nil
+}
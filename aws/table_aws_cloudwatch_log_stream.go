@@ -0,0 +1,131 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCloudwatchLogStream(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudwatch_log_stream",
+		Description: "AWS CloudWatch Log Stream",
+		List: &plugin.ListConfig{
+			ParentHydrate: listCloudwatchLogGroups,
+			Hydrate:       listCloudwatchLogStreams,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the log stream.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LogStreamName"),
+			},
+			{
+				Name:        "log_group_name",
+				Description: "The name of the log group the stream belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the log stream.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The creation time of the stream.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreationTime").Transform(convertTimestamp),
+			},
+			{
+				Name:        "first_event_timestamp",
+				Description: "The time of the first event.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("FirstEventTimestamp").Transform(convertTimestamp),
+			},
+			{
+				Name:        "last_event_timestamp",
+				Description: "The time of the most recent log event in the log stream. This is typically updated within an hour of the event.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LastEventTimestamp").Transform(convertTimestamp),
+			},
+			{
+				Name:        "last_ingestion_time",
+				Description: "The ingestion time of the most recent event.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LastIngestionTime").Transform(convertTimestamp),
+			},
+			{
+				Name:        "upload_sequence_token",
+				Description: "The sequence token.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LogStreamName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+// cloudwatchLogStreamInfo is a log stream along with the name of its log group
+type cloudwatchLogStreamInfo struct {
+	LogGroupName *string
+	*cloudwatchlogs.LogStream
+}
+
+//// LIST FUNCTION
+
+func listCloudwatchLogStreams(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	logGroup := h.Item.(*cloudwatchlogs.LogGroup)
+	plugin.Logger(ctx).Trace("listCloudwatchLogStreams", "AWS_REGION", region, "LogGroupName", *logGroup.LogGroupName)
+
+	// Create session
+	svc, err := CloudWatchLogsService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// List call
+	err = svc.DescribeLogStreamsPages(
+		&cloudwatchlogs.DescribeLogStreamsInput{
+			LogGroupName: logGroup.LogGroupName,
+		},
+		func(page *cloudwatchlogs.DescribeLogStreamsOutput, isLast bool) bool {
+			for _, logStream := range page.LogStreams {
+				d.StreamLeafListItem(ctx, &cloudwatchLogStreamInfo{logGroup.LogGroupName, logStream})
+			}
+			return !isLast
+		},
+	)
+	if err != nil {
+		// The log group may have been deleted since it was listed
+		if a, ok := err.(awserr.Error); ok && a.Code() == cloudwatchlogs.ErrCodeResourceNotFoundException {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,136 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCloudwatchLogSubscriptionFilter(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudwatch_log_subscription_filter",
+		Description: "AWS CloudWatch Log Subscription Filter",
+		List: &plugin.ListConfig{
+			ParentHydrate: listCloudwatchLogGroups,
+			Hydrate:       listCloudwatchLogSubscriptionFilters,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the subscription filter.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FilterName"),
+			},
+			{
+				Name:        "log_group_name",
+				Description: "The name of the log group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The creation time of the subscription filter.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreationTime").Transform(convertTimestamp),
+			},
+			{
+				Name:        "filter_pattern",
+				Description: "A symbolic description of how CloudWatch Logs should interpret the data in each log event.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "destination_arn",
+				Description: "The Amazon Resource Name (ARN) of the destination, such as a Kinesis stream, Kinesis Data Firehose delivery stream, Lambda function or CloudWatch Logs destination.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "role_arn",
+				Description: "The ARN of the IAM role that grants CloudWatch Logs permissions to deliver ingested log events to the destination.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "distribution",
+				Description: "The method used to distribute log data to the destination (Random | ByLogStream).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FilterName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudwatchLogSubscriptionFilterAkas,
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudwatchLogSubscriptionFilters(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	logGroup := h.Item.(*cloudwatchlogs.LogGroup)
+	plugin.Logger(ctx).Trace("listCloudwatchLogSubscriptionFilters", "AWS_REGION", region, "LogGroupName", *logGroup.LogGroupName)
+
+	// Create session
+	svc, err := CloudWatchLogsService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// List call
+	err = svc.DescribeSubscriptionFiltersPages(
+		&cloudwatchlogs.DescribeSubscriptionFiltersInput{
+			LogGroupName: logGroup.LogGroupName,
+		},
+		func(page *cloudwatchlogs.DescribeSubscriptionFiltersOutput, isLast bool) bool {
+			for _, subscriptionFilter := range page.SubscriptionFilters {
+				d.StreamLeafListItem(ctx, subscriptionFilter)
+			}
+			return !isLast
+		},
+	)
+	if err != nil {
+		// The log group may have been deleted since it was listed
+		if a, ok := err.(awserr.Error); ok && a.Code() == cloudwatchlogs.ErrCodeResourceNotFoundException {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCloudwatchLogSubscriptionFilterAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getCloudwatchLogSubscriptionFilterAkas")
+	subscriptionFilter := h.Item.(*cloudwatchlogs.SubscriptionFilter)
+
+	commonColumnData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	commonData := commonColumnData.(*awsCommonColumnData)
+	// Get data for turbot defined properties
+	akas := []string{"arn:" + commonData.Partition + ":logs:" + commonData.Region + ":" + commonData.AccountId + ":log-group:" + *subscriptionFilter.LogGroupName + ":subscription-filter:" + *subscriptionFilter.FilterName}
+
+	return akas, nil
+}
@@ -0,0 +1,181 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCloudwatchMetricDataPoint(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudwatch_metric_data_point",
+		Description: "AWS CloudWatch Metric Data Point",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.AllColumns([]string{"namespace", "metric_name"}),
+			Hydrate:    listCloudwatchMetricDataPoints,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "namespace",
+				Description: "The namespace of the metric, for example AWS/EC2.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "metric_name",
+				Description: "The name of the metric.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "dimensions",
+				Description: "The dimensions of the metric, as an array of Name and Value objects. If not specified in the query, the metric without dimensions is returned.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "period",
+				Description: "The granularity, in seconds, of the data points. Defaults to 300.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "statistic",
+				Description: "The statistic of the data points (Average | Sum | Minimum | Maximum | SampleCount | pNN.NN). Defaults to Average.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "timestamp",
+				Description: "The time stamp of the data point. Defaults to the last 24 hours if no range is given in the query.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "value",
+				Description: "The value of the statistic for the data point.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "label",
+				Description: "The human-readable label of the metric.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Label"),
+			},
+		}),
+	}
+}
+
+// cloudWatchMetricDataPoint is a single value of a metric query. The query
+// parameters are returned with each value so they match the quals.
+type cloudWatchMetricDataPoint struct {
+	Namespace  string
+	MetricName string
+	Dimensions []*cloudwatch.Dimension
+	Period     int64
+	Statistic  string
+	Label      *string
+	Timestamp  *time.Time
+	Value      *float64
+}
+
+//// LIST FUNCTION
+
+func listCloudwatchMetricDataPoints(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	plugin.Logger(ctx).Trace("listCloudwatchMetricDataPoints", "AWS_REGION", region)
+
+	// Create session
+	svc, err := CloudWatchService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	quals := d.KeyColumnQuals
+	query := &cloudWatchMetricDataPoint{
+		Namespace:  quals["namespace"].GetStringValue(),
+		MetricName: quals["metric_name"].GetStringValue(),
+		Dimensions: []*cloudwatch.Dimension{},
+		Period:     300,
+		Statistic:  "Average",
+	}
+	if dimensions := getQualsJsonbValue(d, "dimensions"); dimensions != nil {
+		if err := json.Unmarshal([]byte(*dimensions), &query.Dimensions); err != nil {
+			return nil, fmt.Errorf("dimensions must be an array of Name and Value objects: %v", err)
+		}
+	}
+	if period := getQualsInt64Value(d, "period"); period != nil {
+		query.Period = *period
+	}
+	if statistic := getQualsStringValue(d, "statistic"); statistic != nil {
+		query.Statistic = *statistic
+	}
+
+	endTime := time.Now()
+	startTime, end := getQualsTimeRange(d, "timestamp", time.Duration(query.Period)*time.Second)
+	if end != nil {
+		endTime = *end
+	}
+	if startTime == nil {
+		start := endTime.Add(-24 * time.Hour)
+		startTime = &start
+	}
+
+	params := &cloudwatch.GetMetricDataInput{
+		StartTime: startTime,
+		EndTime:   aws.Time(endTime),
+		ScanBy:    aws.String(cloudwatch.ScanByTimestampAscending),
+		MetricDataQueries: []*cloudwatch.MetricDataQuery{
+			{
+				Id: aws.String("m1"),
+				MetricStat: &cloudwatch.MetricStat{
+					Metric: &cloudwatch.Metric{
+						Namespace:  aws.String(query.Namespace),
+						MetricName: aws.String(query.MetricName),
+						Dimensions: query.Dimensions,
+					},
+					Period: aws.Int64(query.Period),
+					Stat:   aws.String(query.Statistic),
+				},
+			},
+		},
+	}
+
+	// List call
+	err = svc.GetMetricDataPages(
+		params,
+		func(page *cloudwatch.GetMetricDataOutput, isLast bool) bool {
+			for _, result := range page.MetricDataResults {
+				for i, timestamp := range result.Timestamps {
+					d.StreamListItem(ctx, &cloudWatchMetricDataPoint{
+						Namespace:  query.Namespace,
+						MetricName: query.MetricName,
+						Dimensions: query.Dimensions,
+						Period:     query.Period,
+						Statistic:  query.Statistic,
+						Label:      result.Label,
+						Timestamp:  timestamp,
+						Value:      result.Values[i],
+					})
+				}
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
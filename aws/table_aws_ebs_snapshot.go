@@ -3,6 +3,7 @@ package aws
 import (
 	"context"
 
+	"github.com/turbot/go-kit/types"
 	pb "github.com/turbot/steampipe-plugin-sdk/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
@@ -97,6 +98,20 @@ func tableAwsEBSSnapshot(_ context.Context) *plugin.Table {
 				Type:        pb.ColumnType_JSON,
 				Hydrate:     getAwsEBSSnapshotCreateVolumePermissions,
 			},
+			{
+				Name:        "is_public",
+				Description: "Indicates whether the create volume permissions of the snapshot grant access to all AWS accounts",
+				Type:        pb.ColumnType_BOOL,
+				Hydrate:     getAwsEBSSnapshotCreateVolumePermissions,
+				Transform:   transform.FromField("CreateVolumePermissions").Transform(ebsSnapshotCreateVolumePermissionsPublic),
+			},
+			{
+				Name:        "shared_with_account_ids",
+				Description: "The IDs of the AWS accounts the snapshot is explicitly shared with",
+				Type:        pb.ColumnType_JSON,
+				Hydrate:     getAwsEBSSnapshotCreateVolumePermissions,
+				Transform:   transform.FromField("CreateVolumePermissions").Transform(ebsSnapshotCreateVolumePermissionsAccountIds),
+			},
 			{
 				Name:        "tags_src",
 				Description: "A list of tags assigned to the snapshot",
@@ -262,3 +277,27 @@ func ec2SnapshotTurbotTags(_ context.Context, d *transform.TransformData) (inter
 	snapshot := d.HydrateItem.(*ec2.Snapshot)
 	return ec2TagsToMap(snapshot.Tags)
 }
+
+func ebsSnapshotCreateVolumePermissionsPublic(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	permissions, ok := d.Value.([]*ec2.CreateVolumePermission)
+	if !ok {
+		return false, nil
+	}
+	for _, permission := range permissions {
+		if types.SafeString(permission.Group) == ec2.PermissionGroupAll {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func ebsSnapshotCreateVolumePermissionsAccountIds(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	permissions, _ := d.Value.([]*ec2.CreateVolumePermission)
+	accountIds := []string{}
+	for _, permission := range permissions {
+		if permission.UserId != nil {
+			accountIds = append(accountIds, *permission.UserId)
+		}
+	}
+	return accountIds, nil
+}
@@ -0,0 +1,164 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	pb "github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEBSSnapshotShared(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ebs_snapshot_shared",
+		Description: "AWS EBS Snapshot shared with the account by other accounts",
+		List: &plugin.ListConfig{
+			Hydrate: listAwsEBSSharedSnapshots,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "snapshot_id",
+				Description: "The ID of the snapshot. Each snapshot receives a unique identifier when it is created",
+				Type:        pb.ColumnType_STRING,
+			},
+			{
+				Name:        "owner_id",
+				Description: "The AWS account ID of the EBS snapshot owner",
+				Type:        pb.ColumnType_STRING,
+			},
+			{
+				Name:        "owner_alias",
+				Description: "The AWS owner alias, from an Amazon-maintained list (amazon). This is not the user-configured AWS account alias set using the IAM console",
+				Type:        pb.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The snapshot state",
+				Type:        pb.ColumnType_STRING,
+			},
+			{
+				Name:        "volume_size",
+				Description: "The size of the volume, in GiB",
+				Type:        pb.ColumnType_INT,
+			},
+			{
+				Name:        "volume_id",
+				Description: "The ID of the volume that was used to create the snapshot",
+				Type:        pb.ColumnType_STRING,
+			},
+			{
+				Name:        "encrypted",
+				Description: "Indicates whether the snapshot is encrypted",
+				Type:        pb.ColumnType_BOOL,
+			},
+			{
+				Name:        "kms_key_id",
+				Description: "The Amazon Resource Name (ARN) of the AWS Key Management Service (AWS KMS) customer master key (CMK) that was used to protect the volume encryption key for the parent volume",
+				Type:        pb.ColumnType_STRING,
+			},
+			{
+				Name:        "start_time",
+				Description: "The time stamp when the snapshot was initiated",
+				Type:        pb.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "description",
+				Description: "The description for the snapshot",
+				Type:        pb.ColumnType_STRING,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the snapshot",
+				Type:        pb.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			/// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        pb.ColumnType_STRING,
+				Transform:   transform.FromField("SnapshotId"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        pb.ColumnType_JSON,
+				Transform:   transform.From(ec2SnapshotTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        pb.ColumnType_JSON,
+				Hydrate:     getAwsEBSSharedSnapshotAka,
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsEBSSharedSnapshots(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	plugin.Logger(ctx).Trace("listAwsEBSSharedSnapshots", "AWS_REGION", region)
+
+	// Create session
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	accountID := commonData.(*awsCommonColumnData).AccountId
+
+	// List call
+	err = svc.DescribeSnapshotsPages(
+		&ec2.DescribeSnapshotsInput{
+			RestorableByUserIds: []*string{aws.String("self")},
+		},
+		func(page *ec2.DescribeSnapshotsOutput, isLast bool) bool {
+			for _, snapshot := range page.Snapshots {
+				// Skip the account's own snapshots, which are listed by aws_ebs_snapshot
+				if *snapshot.OwnerId == accountID {
+					continue
+				}
+				d.StreamListItem(ctx, snapshot)
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+// The snapshot belongs to its owner's account, not the querying account
+func getAwsEBSSharedSnapshotAka(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getAwsEBSSharedSnapshotAka")
+	snapshot := h.Item.(*ec2.Snapshot)
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Get data for turbot defined properties
+	akas := []string{"arn:" + commonColumnData.Partition + ":ec2:" + commonColumnData.Region + ":" + *snapshot.OwnerId + ":snapshot/" + *snapshot.SnapshotId}
+
+	return akas, nil
+}
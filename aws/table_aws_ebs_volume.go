@@ -2,9 +2,13 @@ package aws
 
 import (
 	"context"
+	"math"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/turbot/go-kit/types"
 	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
@@ -108,6 +112,55 @@ func tableAwsEBSVolume(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Hydrate:     getVolumeProductCodes,
 			},
+			{
+				Name:        "metric_read_ops",
+				Description: "The total number of read operations on the volume over the last ebs_volume_metric_days days (14 by default), from CloudWatch",
+				Type:        proto.ColumnType_DOUBLE,
+				Hydrate:     getEBSVolumeMetrics,
+				Transform:   transform.FromField("ReadOps"),
+			},
+			{
+				Name:        "metric_write_ops",
+				Description: "The total number of write operations on the volume over the last ebs_volume_metric_days days (14 by default), from CloudWatch",
+				Type:        proto.ColumnType_DOUBLE,
+				Hydrate:     getEBSVolumeMetrics,
+				Transform:   transform.FromField("WriteOps"),
+			},
+			{
+				Name:        "metric_idle_time_percent",
+				Description: "The percentage of the last ebs_volume_metric_days days (14 by default) in which no read or write operations were submitted to the volume, from CloudWatch. Null if the volume reported no metrics",
+				Type:        proto.ColumnType_DOUBLE,
+				Hydrate:     getEBSVolumeMetrics,
+				Transform:   transform.FromField("IdleTimePercent"),
+			},
+			{
+				Name:        "is_idle",
+				Description: "Indicates whether the volume had no read or write operations over the last ebs_volume_metric_days days (14 by default)",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getEBSVolumeMetrics,
+				Transform:   transform.FromField("IsIdle"),
+			},
+			{
+				Name:        "unattached_since",
+				Description: "For a volume that is not attached, a conservative estimate of when it was detached: the later of its create time and the start time of its most recent snapshot",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getEBSVolumeUnattachedSince,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "unattached_days",
+				Description: "The number of whole days since unattached_since. Zero for attached volumes",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getEBSVolumeUnattachedSince,
+				Transform:   transform.From(ebsVolumeUnattachedDays),
+			},
+			{
+				Name:        "gp3_monthly_savings_usd",
+				Description: "For a gp2 volume, the estimated monthly saving in USD of migrating it to gp3 with the same baseline IOPS, from the AWS Price List. Throughput above the gp3 baseline is not included",
+				Type:        proto.ColumnType_DOUBLE,
+				Hydrate:     getEBSVolumeGp3MonthlySavings,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "tags_src",
 				Description: "A list of tags assigned to the volume",
@@ -299,6 +352,211 @@ func getEBSVolumeAkas(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 	}, nil
 }
 
+// ebsVolumeMetrics holds the CloudWatch usage of a volume
+type ebsVolumeMetrics struct {
+	ReadOps         float64
+	WriteOps        float64
+	IdleTimePercent *float64
+	IsIdle          bool
+}
+
+func getEBSVolumeMetrics(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getEBSVolumeMetrics")
+	volume := h.Item.(*ec2.Volume)
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+
+	// Create session
+	svc, err := CloudWatchService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	days := 14
+	awsConfig := GetConfig(d.Connection)
+	if awsConfig.EBSVolumeMetricDays != nil && *awsConfig.EBSVolumeMetricDays > 0 {
+		days = *awsConfig.EBSVolumeMetricDays
+	}
+	endTime := time.Now().Truncate(24 * time.Hour)
+	startTime := endTime.AddDate(0, 0, -days)
+
+	// Daily sums of each metric over the period
+	var queries []*cloudwatch.MetricDataQuery
+	for id, metricName := range map[string]string{"read": "VolumeReadOps", "write": "VolumeWriteOps", "idle": "VolumeIdleTime"} {
+		queries = append(queries, &cloudwatch.MetricDataQuery{
+			Id: aws.String(id),
+			MetricStat: &cloudwatch.MetricStat{
+				Metric: &cloudwatch.Metric{
+					Namespace:  aws.String("AWS/EBS"),
+					MetricName: aws.String(metricName),
+					Dimensions: []*cloudwatch.Dimension{
+						{
+							Name:  aws.String("VolumeId"),
+							Value: volume.VolumeId,
+						},
+					},
+				},
+				Period: aws.Int64(86400),
+				Stat:   aws.String(cloudwatch.StatisticSum),
+			},
+		})
+	}
+
+	totals := map[string]float64{}
+	datapoints := map[string]int{}
+	err = svc.GetMetricDataPages(
+		&cloudwatch.GetMetricDataInput{
+			MetricDataQueries: queries,
+			StartTime:         aws.Time(startTime),
+			EndTime:           aws.Time(endTime),
+		},
+		func(page *cloudwatch.GetMetricDataOutput, isLast bool) bool {
+			for _, result := range page.MetricDataResults {
+				for _, value := range result.Values {
+					totals[*result.Id] += *value
+					datapoints[*result.Id]++
+				}
+			}
+			return !isLast
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := &ebsVolumeMetrics{
+		ReadOps:  totals["read"],
+		WriteOps: totals["write"],
+		IsIdle:   totals["read"]+totals["write"] == 0,
+	}
+	// Volumes only report metrics while attached, so the idle time is relative
+	// to the days for which there are datapoints
+	if datapoints["idle"] > 0 {
+		percent := totals["idle"] / float64(datapoints["idle"]*86400) * 100
+		metrics.IdleTimePercent = &percent
+	}
+
+	return metrics, nil
+}
+
+// getEBSVolumeUnattachedSince estimates when an unattached volume was detached.
+// EC2 does not record detach times, so this is the later of the create time and
+// the most recent snapshot, as snapshots are usually taken of volumes in use.
+// The estimate errs towards the volume having been unattached for less time.
+func getEBSVolumeUnattachedSince(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getEBSVolumeUnattachedSince")
+	volume := h.Item.(*ec2.Volume)
+	if len(volume.Attachments) > 0 || volume.CreateTime == nil {
+		return nil, nil
+	}
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+
+	// Create session
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	unattachedSince := *volume.CreateTime
+	err = svc.DescribeSnapshotsPages(
+		&ec2.DescribeSnapshotsInput{
+			OwnerIds: []*string{aws.String("self")},
+			Filters: []*ec2.Filter{
+				{
+					Name:   aws.String("volume-id"),
+					Values: []*string{volume.VolumeId},
+				},
+			},
+		},
+		func(page *ec2.DescribeSnapshotsOutput, isLast bool) bool {
+			for _, snapshot := range page.Snapshots {
+				if snapshot.StartTime != nil && snapshot.StartTime.After(unattachedSince) {
+					unattachedSince = *snapshot.StartTime
+				}
+			}
+			return !isLast
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return unattachedSince, nil
+}
+
+// ebsVolumePrices holds the Price List prices used to compare gp2 and gp3 volumes
+type ebsVolumePrices struct {
+	Gp2GbMonth   *float64
+	Gp3GbMonth   *float64
+	Gp3IopsMonth *float64
+}
+
+func getEBSVolumeGp3MonthlySavings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getEBSVolumeGp3MonthlySavings")
+	volume := h.Item.(*ec2.Volume)
+	if types.SafeString(volume.VolumeType) != ec2.VolumeTypeGp2 || volume.Size == nil {
+		return nil, nil
+	}
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// The Price List API only covers the commercial partition
+	if commonColumnData.Partition != "aws" {
+		return nil, nil
+	}
+
+	prices := getEBSVolumePrices(ctx, d, commonColumnData.Region)
+	if prices.Gp2GbMonth == nil || prices.Gp3GbMonth == nil || prices.Gp3IopsMonth == nil {
+		return nil, nil
+	}
+
+	// gp2 volumes get 3 IOPS per GiB, between 100 and 16,000. gp3 volumes
+	// include 3,000 IOPS, and more must be provisioned and paid for.
+	size := float64(*volume.Size)
+	baselineIops := math.Min(math.Max(3*size, 100), 16000)
+	extraIops := math.Max(baselineIops-3000, 0)
+
+	savings := size*(*prices.Gp2GbMonth-*prices.Gp3GbMonth) - extraIops**prices.Gp3IopsMonth
+	return savings, nil
+}
+
+// getEBSVolumePrices returns the gp2 and gp3 prices for the region. Prices
+// that cannot be found are nil, so only the savings are left out.
+func getEBSVolumePrices(ctx context.Context, d *plugin.QueryData, region string) *ebsVolumePrices {
+	prices := &ebsVolumePrices{}
+	prices.Gp2GbMonth = getOnDemandPriceUsd(ctx, d, "AmazonEC2", "GB-Mo", map[string]string{
+		"regionCode":    region,
+		"productFamily": "Storage",
+		"volumeApiName": "gp2",
+	})
+	prices.Gp3GbMonth = getOnDemandPriceUsd(ctx, d, "AmazonEC2", "GB-Mo", map[string]string{
+		"regionCode":    region,
+		"productFamily": "Storage",
+		"volumeApiName": "gp3",
+	})
+	prices.Gp3IopsMonth = getOnDemandPriceUsd(ctx, d, "AmazonEC2", "IOPS-Mo", map[string]string{
+		"regionCode":    region,
+		"productFamily": "System Operation",
+		"volumeApiName": "gp3",
+		"group":         "EBS IOPS",
+	})
+
+	return prices
+}
+
 //// TRANSFORM FUNCTIONS
 
 func getEBSVolumeTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
@@ -306,6 +564,14 @@ func getEBSVolumeTurbotTags(_ context.Context, d *transform.TransformData) (inte
 	return ec2TagsToMap(volume.Tags)
 }
 
+func ebsVolumeUnattachedDays(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	unattachedSince, ok := d.HydrateItem.(time.Time)
+	if !ok {
+		return 0, nil
+	}
+	return int(time.Since(unattachedSince).Hours() / 24), nil
+}
+
 func getEBSVolumeTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
 	volume := d.HydrateItem.(*ec2.Volume)
 
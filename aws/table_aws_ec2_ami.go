@@ -3,6 +3,7 @@ package aws
 import (
 	"context"
 
+	"github.com/turbot/go-kit/types"
 	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
 
@@ -155,6 +156,20 @@ func tableAwsEc2Ami(_ context.Context) *plugin.Table {
 				Hydrate:     getAwsEc2AmiLaunchPermissionData,
 				Transform:   transform.FromField("LaunchPermissions"),
 			},
+			{
+				Name:        "is_public",
+				Description: "Indicates whether the launch permissions of the AMI grant access to all AWS accounts",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getAwsEc2AmiLaunchPermissionData,
+				Transform:   transform.FromField("LaunchPermissions").Transform(ec2AmiLaunchPermissionsPublic),
+			},
+			{
+				Name:        "shared_with_account_ids",
+				Description: "The IDs of the AWS accounts the AMI is explicitly shared with",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsEc2AmiLaunchPermissionData,
+				Transform:   transform.FromField("LaunchPermissions").Transform(ec2AmiLaunchPermissionsAccountIds),
+			},
 			{
 				Name:        "tags_src",
 				Description: "A list of tags attached to the AMI",
@@ -306,6 +321,30 @@ func getEc2AmiTurbotTags(_ context.Context, d *transform.TransformData) (interfa
 	return ec2TagsToMap(image.Tags)
 }
 
+func ec2AmiLaunchPermissionsPublic(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	permissions, ok := d.Value.([]*ec2.LaunchPermission)
+	if !ok {
+		return false, nil
+	}
+	for _, permission := range permissions {
+		if types.SafeString(permission.Group) == ec2.PermissionGroupAll {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func ec2AmiLaunchPermissionsAccountIds(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	permissions, _ := d.Value.([]*ec2.LaunchPermission)
+	accountIds := []string{}
+	for _, permission := range permissions {
+		if permission.UserId != nil {
+			accountIds = append(accountIds, *permission.UserId)
+		}
+	}
+	return accountIds, nil
+}
+
 func getEc2AmiTurbotTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
 	data := d.HydrateItem.(*ec2.Image)
 
@@ -0,0 +1,182 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEc2AmiShared(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ec2_ami_shared",
+		Description: "AWS EC2 AMI shared with the account by other accounts",
+		List: &plugin.ListConfig{
+			Hydrate: listEc2SharedAmis,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the AMI that was provided during image creation",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "image_id",
+				Description: "The ID of the AMI",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "owner_id",
+				Description: "The AWS account ID of the image owner",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "image_owner_alias",
+				Description: "The AWS account alias (for example, amazon, self) or the AWS account ID of the AMI owner",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the AMI. If the state is available, the image is successfully registered and can be used to launch an instance",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "image_type",
+				Description: "The type of image",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_date",
+				Description: "The date and time the image was created",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the AMI that was provided during image creation",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "architecture",
+				Description: "The architecture of the image",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "platform_details",
+				Description: "The platform details associated with the billing code of the AMI",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "public",
+				Description: "Indicates whether the image has public launch permissions",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "root_device_type",
+				Description: "The type of root device used by the AMI. The AMI can use an EBS volume or an instance store volume",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "block_device_mappings",
+				Description: "Any block device mapping entries",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "product_codes",
+				Description: "Any product codes associated with the AMI",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags attached to the AMI",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(getEc2AmiTurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(getEc2AmiTurbotTitle),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getEc2SharedAmiAkas,
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEc2SharedAmis(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	plugin.Logger(ctx).Trace("listEc2SharedAmis", "AWS_REGION", region)
+
+	// Create Session
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	accountID := commonData.(*awsCommonColumnData).AccountId
+
+	// Images this account has explicit launch permissions for
+	resp, err := svc.DescribeImages(&ec2.DescribeImagesInput{
+		ExecutableUsers: []*string{aws.String("self")},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, image := range resp.Images {
+		// Skip the account's own images, which are listed by aws_ec2_ami
+		if *image.OwnerId == accountID {
+			continue
+		}
+		d.StreamListItem(ctx, image)
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+// The AMI belongs to its owner's account, not the querying account
+func getEc2SharedAmiAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getEc2SharedAmiAkas")
+	image := h.Item.(*ec2.Image)
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Get data for turbot defined properties
+	akas := []string{"arn:" + commonColumnData.Partition + ":ec2:" + commonColumnData.Region + ":" + *image.OwnerId + ":image/" + *image.ImageId}
+
+	return akas, nil
+}
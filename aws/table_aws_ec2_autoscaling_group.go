@@ -2,8 +2,13 @@ package aws
 
 import (
 	"context"
+	"strconv"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/turbot/go-kit/helpers"
+	"github.com/turbot/go-kit/types"
 
 	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/plugin"
@@ -127,6 +132,13 @@ func tableAwsEc2ASG(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("LaunchTemplate.Version"),
 			},
+			{
+				Name:        "launch_template_version_number",
+				Description: "The launch template version number in use, with $Latest and $Default resolved",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getAwsEc2AutoscalingGroupLaunchTemplateVersions,
+				Transform:   transform.FromField("LaunchTemplateVersionNumber"),
+			},
 			{
 				Name:        "on_demand_allocation_strategy",
 				Description: "Indicates how to allocate instance types to fulfill On-Demand capacity. The only valid value is prioritized, which is also the default value. This strategy uses the order of instance types in the overrides to define the launch priority of each instance type",
@@ -181,6 +193,13 @@ func tableAwsEc2ASG(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("MixedInstancesPolicy.LaunchTemplate.LaunchTemplateSpecification.Version"),
 			},
+			{
+				Name:        "mixed_instances_policy_launch_template_version_number",
+				Description: "The launch template version number in use for mixed instances policy, with $Latest and $Default resolved",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getAwsEc2AutoscalingGroupLaunchTemplateVersions,
+				Transform:   transform.FromField("MixedInstancesPolicyLaunchTemplateVersionNumber"),
+			},
 			{
 				Name:        "mixed_instances_policy_launch_template_overrides",
 				Description: "Any parameters that is specified in the list override the same parameters in the launch template",
@@ -372,6 +391,80 @@ func getAwsEc2AutoscalingGroupPolicy(ctx context.Context, d *plugin.QueryData, h
 	return policies, nil
 }
 
+// custom struct for the launch template versions used by an autoscaling group
+type autoscalingGroupLaunchTemplateVersions struct {
+	LaunchTemplateVersionNumber                     *int64
+	MixedInstancesPolicyLaunchTemplateVersionNumber *int64
+}
+
+func getAwsEc2AutoscalingGroupLaunchTemplateVersions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getAwsEc2AutoscalingGroupLaunchTemplateVersions")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	asg := h.Item.(*autoscaling.Group)
+
+	versions := &autoscalingGroupLaunchTemplateVersions{}
+	var err error
+
+	if asg.LaunchTemplate != nil {
+		versions.LaunchTemplateVersionNumber, err = resolveLaunchTemplateVersion(ctx, d, region, asg.LaunchTemplate)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if asg.MixedInstancesPolicy != nil && asg.MixedInstancesPolicy.LaunchTemplate != nil && asg.MixedInstancesPolicy.LaunchTemplate.LaunchTemplateSpecification != nil {
+		versions.MixedInstancesPolicyLaunchTemplateVersionNumber, err = resolveLaunchTemplateVersion(ctx, d, region, asg.MixedInstancesPolicy.LaunchTemplate.LaunchTemplateSpecification)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return versions, nil
+}
+
+// resolveLaunchTemplateVersion returns the version number a launch template
+// specification refers to. $Latest and $Default, or no version at all, are
+// looked up on the launch template.
+func resolveLaunchTemplateVersion(ctx context.Context, d *plugin.QueryData, region string, specification *autoscaling.LaunchTemplateSpecification) (*int64, error) {
+	version := types.SafeString(specification.Version)
+	if number, err := strconv.ParseInt(version, 10, 64); err == nil {
+		return &number, nil
+	}
+
+	// Create Session
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &ec2.DescribeLaunchTemplatesInput{}
+	if specification.LaunchTemplateId != nil {
+		params.LaunchTemplateIds = []*string{specification.LaunchTemplateId}
+	} else {
+		params.LaunchTemplateNames = []*string{specification.LaunchTemplateName}
+	}
+
+	op, err := svc.DescribeLaunchTemplates(params)
+	if err != nil {
+		if a, ok := err.(awserr.Error); ok && helpers.StringSliceContains([]string{"InvalidLaunchTemplateId.NotFound", "InvalidLaunchTemplateName.NotFoundException"}, a.Code()) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(op.LaunchTemplates) == 0 {
+		return nil, nil
+	}
+
+	if version == "$Latest" {
+		return op.LaunchTemplates[0].LatestVersionNumber, nil
+	}
+	return op.LaunchTemplates[0].DefaultVersionNumber, nil
+}
+
 //// TRANSFORM FUNCTIONS
 
 func getASGTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
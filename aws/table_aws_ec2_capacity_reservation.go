@@ -0,0 +1,244 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEc2CapacityReservation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ec2_capacity_reservation",
+		Description: "AWS EC2 Capacity Reservation",
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("capacity_reservation_id"),
+			ShouldIgnoreError: isNotFoundError([]string{"InvalidCapacityReservationId.Malformed", "InvalidCapacityReservationId.NotFound"}),
+			ItemFromKey:       capacityReservationFromKey,
+			Hydrate:           getEc2CapacityReservation,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listEc2CapacityReservations,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "capacity_reservation_id",
+				Description: "The ID of the Capacity Reservation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "capacity_reservation_arn",
+				Description: "The Amazon Resource Name (ARN) of the Capacity Reservation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the Capacity Reservation (active | expired | cancelled | pending | failed).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "instance_type",
+				Description: "The type of instance for which the Capacity Reservation reserves capacity.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "instance_platform",
+				Description: "The type of operating system for which the Capacity Reservation reserves capacity.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "availability_zone",
+				Description: "The Availability Zone in which the capacity is reserved.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "availability_zone_id",
+				Description: "The Availability Zone ID of the Capacity Reservation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenancy",
+				Description: "Indicates the tenancy of the Capacity Reservation (default | dedicated).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "total_instance_count",
+				Description: "The total number of instances for which the Capacity Reservation reserves capacity.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "available_instance_count",
+				Description: "The remaining capacity. Indicates the number of instances that can be launched in the Capacity Reservation.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "instance_match_criteria",
+				Description: "Indicates the type of instance launches that the Capacity Reservation accepts (open | targeted).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "ebs_optimized",
+				Description: "Indicates whether the Capacity Reservation supports EBS-optimized instances.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "ephemeral_storage",
+				Description: "Indicates whether the Capacity Reservation supports instances with temporary, block-level storage.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "create_date",
+				Description: "The date and time at which the Capacity Reservation was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "start_date",
+				Description: "The date and time at which the Capacity Reservation was started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "end_date",
+				Description: "The date and time at which the Capacity Reservation expires.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "end_date_type",
+				Description: "Indicates the way in which the Capacity Reservation ends (unlimited | limited).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "owner_id",
+				Description: "The ID of the AWS account that owns the Capacity Reservation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "outpost_arn",
+				Description: "The Amazon Resource Name (ARN) of the Outpost on which the Capacity Reservation was created.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "placement_group_arn",
+				Description: "The Amazon Resource Name (ARN) of the cluster placement group in which the Capacity Reservation was created.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "capacity_allocations",
+				Description: "Information about instance capacity usage.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the Capacity Reservation.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(getEc2CapacityReservationTurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CapacityReservationId"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CapacityReservationArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// BUILD HYDRATE INPUT
+
+func capacityReservationFromKey(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	quals := d.KeyColumnQuals
+	capacityReservationID := quals["capacity_reservation_id"].GetStringValue()
+	item := &ec2.CapacityReservation{
+		CapacityReservationId: &capacityReservationID,
+	}
+	return item, nil
+}
+
+//// LIST FUNCTION
+
+func listEc2CapacityReservations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	plugin.Logger(ctx).Trace("listEc2CapacityReservations", "AWS_REGION", region)
+
+	// Create session
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// List call
+	err = svc.DescribeCapacityReservationsPages(
+		&ec2.DescribeCapacityReservationsInput{},
+		func(page *ec2.DescribeCapacityReservationsOutput, isLast bool) bool {
+			for _, reservation := range page.CapacityReservations {
+				d.StreamListItem(ctx, reservation)
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getEc2CapacityReservation(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getEc2CapacityReservation")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	reservation := h.Item.(*ec2.CapacityReservation)
+
+	// Create session
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &ec2.DescribeCapacityReservationsInput{
+		CapacityReservationIds: []*string{reservation.CapacityReservationId},
+	}
+
+	op, err := svc.DescribeCapacityReservations(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(op.CapacityReservations) > 0 {
+		return op.CapacityReservations[0], nil
+	}
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func getEc2CapacityReservationTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	reservation := d.HydrateItem.(*ec2.CapacityReservation)
+	return ec2TagsToMap(reservation.Tags)
+}
@@ -0,0 +1,242 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEc2DedicatedHost(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ec2_dedicated_host",
+		Description: "AWS EC2 Dedicated Host",
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("host_id"),
+			ShouldIgnoreError: isNotFoundError([]string{"InvalidHostID.Malformed", "InvalidHostID.NotFound"}),
+			ItemFromKey:       dedicatedHostFromKey,
+			Hydrate:           getEc2DedicatedHost,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listEc2DedicatedHosts,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "host_id",
+				Description: "The ID of the Dedicated Host.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The Dedicated Host's state (available | under-assessment | permanent-failure | released | released-permanent-failure | pending).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "instance_type",
+				Description: "The instance type supported by the Dedicated Host, if it supports a single instance type.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HostProperties.InstanceType"),
+			},
+			{
+				Name:        "instance_family",
+				Description: "The instance family supported by the Dedicated Host.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HostProperties.InstanceFamily"),
+			},
+			{
+				Name:        "availability_zone",
+				Description: "The Availability Zone of the Dedicated Host.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "availability_zone_id",
+				Description: "The ID of the Availability Zone in which the Dedicated Host is allocated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "allocation_time",
+				Description: "The time that the Dedicated Host was allocated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "release_time",
+				Description: "The time that the Dedicated Host was released.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "auto_placement",
+				Description: "Whether auto-placement is on or off.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "host_recovery",
+				Description: "Indicates whether host recovery is enabled or disabled for the Dedicated Host.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "allows_multiple_instance_types",
+				Description: "Indicates whether the Dedicated Host supports multiple instance types of the same instance family.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "host_reservation_id",
+				Description: "The reservation ID of the Dedicated Host. This returns a null response if the Dedicated Host doesn't have an associated reservation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "owner_id",
+				Description: "The ID of the AWS account that owns the Dedicated Host.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "outpost_arn",
+				Description: "The Amazon Resource Name (ARN) of the Outpost on which the Dedicated Host is allocated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "host_properties",
+				Description: "The hardware specifications of the Dedicated Host.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "available_capacity",
+				Description: "Information about the instances running on the Dedicated Host and the capacity that is still available.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "instances",
+				Description: "The IDs and instance type that are currently running on the Dedicated Host.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the Dedicated Host.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(getEc2DedicatedHostTurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HostId"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getEc2DedicatedHostAkas,
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+//// BUILD HYDRATE INPUT
+
+func dedicatedHostFromKey(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	quals := d.KeyColumnQuals
+	hostID := quals["host_id"].GetStringValue()
+	item := &ec2.Host{
+		HostId: &hostID,
+	}
+	return item, nil
+}
+
+//// LIST FUNCTION
+
+func listEc2DedicatedHosts(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	plugin.Logger(ctx).Trace("listEc2DedicatedHosts", "AWS_REGION", region)
+
+	// Create session
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// List call
+	err = svc.DescribeHostsPages(
+		&ec2.DescribeHostsInput{},
+		func(page *ec2.DescribeHostsOutput, isLast bool) bool {
+			for _, host := range page.Hosts {
+				d.StreamListItem(ctx, host)
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getEc2DedicatedHost(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getEc2DedicatedHost")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	host := h.Item.(*ec2.Host)
+
+	// Create session
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &ec2.DescribeHostsInput{
+		HostIds: []*string{host.HostId},
+	}
+
+	op, err := svc.DescribeHosts(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(op.Hosts) > 0 {
+		return op.Hosts[0], nil
+	}
+	return nil, nil
+}
+
+func getEc2DedicatedHostAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getEc2DedicatedHostAkas")
+	host := h.Item.(*ec2.Host)
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Get data for turbot defined properties
+	akas := []string{"arn:" + commonColumnData.Partition + ":ec2:" + commonColumnData.Region + ":" + commonColumnData.AccountId + ":dedicated-host/" + *host.HostId}
+
+	return akas, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func getEc2DedicatedHostTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	host := d.HydrateItem.(*ec2.Host)
+	return ec2TagsToMap(host.Tags)
+}
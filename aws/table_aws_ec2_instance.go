@@ -2,6 +2,8 @@ package aws
 
 import (
 	"context"
+	"encoding/base64"
+	"unicode/utf8"
 
 	"github.com/turbot/go-kit/types"
 	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
@@ -202,10 +204,42 @@ func tableAwsEc2Instance(_ context.Context) *plugin.Table {
 			},
 			{
 				Name:        "user_data",
-				Description: "The user data of the instance",
+				Description: "The decoded user data of the instance, decompressed if it is gzip compressed. Null if the user data is not text. Secrets are redacted if scan_user_data_secrets is enabled in the connection config",
 				Type:        proto.ColumnType_STRING,
 				Hydrate:     getInstanceUserData,
-				Transform:   transform.FromField("UserData.Value"),
+				Transform:   transform.FromField("UserData"),
+			},
+			{
+				Name:        "user_data_secrets",
+				Description: "The type and line number of possible secrets found in the user data. Only set if scan_user_data_secrets is enabled in the connection config",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getInstanceUserData,
+				Transform:   transform.FromField("Secrets"),
+			},
+			{
+				Name:        "console_output",
+				Description: "The decoded console output of the instance. Null if the output is not valid UTF-8, or cannot be retrieved for the instance. Only retrieved when the column is selected",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getInstanceConsoleOutput,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "metadata_endpoint_enabled",
+				Description: "Indicates whether the instance metadata service endpoint is enabled",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(ec2InstanceMetadataOption, "HttpEndpoint"),
+			},
+			{
+				Name:        "imdsv2_required",
+				Description: "Indicates whether session tokens (IMDSv2) are required to retrieve instance metadata",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(ec2InstanceMetadataOption, "HttpTokens"),
+			},
+			{
+				Name:        "metadata_hop_limit",
+				Description: "The maximum number of hops that the metadata token can travel",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("MetadataOptions.HttpPutResponseHopLimit"),
 			},
 			{
 				Name:        "virtualization_type",
@@ -237,6 +271,11 @@ func tableAwsEc2Instance(_ context.Context) *plugin.Table {
 				Description: "The network interfaces for the instance",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "metadata_options",
+				Description: "The metadata options for the instance",
+				Type:        proto.ColumnType_JSON,
+			},
 			{
 				Name:        "product_codes",
 				Description: "The product codes attached to this instance, if applicable",
@@ -552,7 +591,55 @@ func getInstanceUserData(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 		return nil, err
 	}
 
-	return instanceData, nil
+	if instanceData.UserData == nil {
+		return &userData{}, nil
+	}
+	return getUserData(d, instanceData.UserData.Value)
+}
+
+func getInstanceConsoleOutput(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getInstanceConsoleOutput")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	instance := h.Item.(*ec2.Instance)
+
+	// create service
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &ec2.GetConsoleOutputInput{
+		InstanceId: instance.InstanceId,
+	}
+
+	op, err := svc.GetConsoleOutput(params)
+	if err != nil {
+		// The output is not available for terminated instances or some instance
+		// types, and may not be readable by the caller, which should not fail
+		// the other columns
+		if isNotFoundError([]string{"InvalidInstanceID.NotFound", "InvalidInstanceID.Unavailable", "UnsupportedOperation", "UnauthorizedOperation"})(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if op.Output == nil {
+		return nil, nil
+	}
+
+	output, err := base64.StdEncoding.DecodeString(*op.Output)
+	if err != nil {
+		return nil, err
+	}
+	if !utf8.Valid(output) {
+		return nil, nil
+	}
+
+	return string(output), nil
 }
 
 func getInstanceStatus(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
@@ -591,6 +678,23 @@ func getEc2InstanceTurbotTags(_ context.Context, d *transform.TransformData) (in
 	return ec2TagsToMap(instance.Tags)
 }
 
+// ec2InstanceMetadataOption returns whether the given metadata option is
+// set to its more restrictive or enabled value
+func ec2InstanceMetadataOption(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	instance := d.HydrateItem.(*ec2.Instance)
+	if instance.MetadataOptions == nil {
+		return nil, nil
+	}
+
+	switch d.Param.(string) {
+	case "HttpEndpoint":
+		return types.SafeString(instance.MetadataOptions.HttpEndpoint) == ec2.InstanceMetadataEndpointStateEnabled, nil
+	case "HttpTokens":
+		return types.SafeString(instance.MetadataOptions.HttpTokens) == ec2.HttpTokensStateRequired, nil
+	}
+	return nil, nil
+}
+
 func getEc2InstanceTurbotTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
 	data := d.HydrateItem.(*ec2.Instance)
 	title := data.InstanceId
@@ -0,0 +1,45 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEc2InstanceMetricCpuUtilizationDaily(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ec2_instance_metric_cpu_utilization_daily",
+		Description: "AWS EC2 Instance CloudWatch CPU utilization metrics, aggregated daily for the last year",
+		List: &plugin.ListConfig{
+			ParentHydrate: listEc2Instance,
+			Hydrate:       listEc2InstanceMetricCpuUtilizationDaily,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: cwMetricColumns([]*plugin.Column{
+			{
+				Name:        "instance_id",
+				Description: "The ID of the instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DimensionValue"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEc2InstanceMetricCpuUtilizationDaily(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	instance := h.Item.(*ec2.Instance)
+
+	// Skip the instances the query does not ask for
+	if instanceID := getQualsStringValue(d, "instance_id"); instanceID != nil && *instanceID != *instance.InstanceId {
+		return nil, nil
+	}
+
+	return listCWMetricStatistics(ctx, d, "AWS/EC2", "CPUUtilization", "InstanceId", *instance.InstanceId)
+}
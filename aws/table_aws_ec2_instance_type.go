@@ -167,6 +167,13 @@ func tableAwsInstanceType(_ context.Context) *plugin.Table {
 				Hydrate:     describeInstanceType,
 				Transform:   transform.FromField("InstanceTypes[0].GpuInfo"),
 			},
+			{
+				Name:        "on_demand_hourly_usd",
+				Description: "The On-Demand price of a Linux instance of the type with shared tenancy, in USD per hour, from the AWS Price List.",
+				Type:        proto.ColumnType_DOUBLE,
+				Hydrate:     getInstanceTypeOnDemandHourlyUsd,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "title",
 				Description: resourceInterfaceDescription("title"),
@@ -301,6 +308,27 @@ func describeInstanceType(ctx context.Context, d *plugin.QueryData, h *plugin.Hy
 	return op, nil
 }
 
+func getInstanceTypeOnDemandHourlyUsd(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getInstanceTypeOnDemandHourlyUsd")
+	instanceInfo := h.Item.(*instanceTypeOfferingInfo)
+
+	// The Price List API only covers the commercial partition
+	if instanceInfo.Partition != "aws" {
+		return nil, nil
+	}
+
+	return getOnDemandHourlyUsd(ctx, d, "AmazonEC2", map[string]string{
+		"regionCode":      instanceInfo.Region,
+		"instanceType":    *instanceInfo.InstanceTypeOffering.InstanceType,
+		"operatingSystem": "Linux",
+		"tenancy":         "Shared",
+		"preInstalledSw":  "NA",
+		"capacitystatus":  "Used",
+		"licenseModel":    "No License required",
+		"marketoption":    "OnDemand",
+	})
+}
+
 //// TRANSFORM FUNCTIONS
 
 func instanceTypeDataToAkas(ctx context.Context, d *transform.TransformData) (interface{}, error) {
@@ -0,0 +1,185 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEc2LaunchTemplate(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ec2_launch_template",
+		Description: "AWS EC2 Launch Template",
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("launch_template_id"),
+			ShouldIgnoreError: isNotFoundError([]string{"InvalidLaunchTemplateId.Malformed", "InvalidLaunchTemplateId.NotFound"}),
+			ItemFromKey:       launchTemplateFromKey,
+			Hydrate:           getEc2LaunchTemplate,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listEc2LaunchTemplates,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "launch_template_name",
+				Description: "The name of the launch template.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "launch_template_id",
+				Description: "The ID of the launch template.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The time the launch template was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "created_by",
+				Description: "The principal that created the launch template.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "default_version_number",
+				Description: "The version number of the default version of the launch template.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "latest_version_number",
+				Description: "The version number of the latest version of the launch template.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the launch template.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(getEc2LaunchTemplateTurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LaunchTemplateName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getEc2LaunchTemplateAkas,
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+//// BUILD HYDRATE INPUT
+
+func launchTemplateFromKey(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	quals := d.KeyColumnQuals
+	launchTemplateID := quals["launch_template_id"].GetStringValue()
+	item := &ec2.LaunchTemplate{
+		LaunchTemplateId: &launchTemplateID,
+	}
+	return item, nil
+}
+
+//// LIST FUNCTION
+
+func listEc2LaunchTemplates(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	plugin.Logger(ctx).Trace("listEc2LaunchTemplates", "AWS_REGION", region)
+
+	// Create session
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// List call
+	err = svc.DescribeLaunchTemplatesPages(
+		&ec2.DescribeLaunchTemplatesInput{},
+		func(page *ec2.DescribeLaunchTemplatesOutput, isLast bool) bool {
+			for _, launchTemplate := range page.LaunchTemplates {
+				d.StreamListItem(ctx, launchTemplate)
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getEc2LaunchTemplate(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getEc2LaunchTemplate")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	launchTemplate := h.Item.(*ec2.LaunchTemplate)
+
+	// Create session
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &ec2.DescribeLaunchTemplatesInput{
+		LaunchTemplateIds: []*string{launchTemplate.LaunchTemplateId},
+	}
+
+	op, err := svc.DescribeLaunchTemplates(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(op.LaunchTemplates) > 0 {
+		return op.LaunchTemplates[0], nil
+	}
+	return nil, nil
+}
+
+func getEc2LaunchTemplateAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getEc2LaunchTemplateAkas")
+	launchTemplate := h.Item.(*ec2.LaunchTemplate)
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Get data for turbot defined properties
+	akas := []string{"arn:" + commonColumnData.Partition + ":ec2:" + commonColumnData.Region + ":" + commonColumnData.AccountId + ":launch-template/" + *launchTemplate.LaunchTemplateId}
+
+	return akas, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func getEc2LaunchTemplateTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	launchTemplate := d.HydrateItem.(*ec2.LaunchTemplate)
+	return ec2TagsToMap(launchTemplate.Tags)
+}
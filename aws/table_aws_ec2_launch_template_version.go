@@ -0,0 +1,352 @@
+package aws
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEc2LaunchTemplateVersion(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ec2_launch_template_version",
+		Description: "AWS EC2 Launch Template Version",
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.AllColumns([]string{"launch_template_id", "version_number"}),
+			ShouldIgnoreError: isNotFoundError([]string{"InvalidLaunchTemplateId.Malformed", "InvalidLaunchTemplateId.NotFound", "InvalidLaunchTemplateId.VersionNotFound"}),
+			ItemFromKey:       launchTemplateVersionFromKey,
+			Hydrate:           getEc2LaunchTemplateVersion,
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listEc2LaunchTemplates,
+			Hydrate:       listEc2LaunchTemplateVersions,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "launch_template_name",
+				Description: "The name of the launch template.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "launch_template_id",
+				Description: "The ID of the launch template.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "version_number",
+				Description: "The version number.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "version_description",
+				Description: "The description for the version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "default_version",
+				Description: "Indicates whether the version is the default version.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "create_time",
+				Description: "The time the version was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "created_by",
+				Description: "The principal that created the version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "image_id",
+				Description: "The ID of the AMI or a Systems Manager parameter that resolves to the AMI.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LaunchTemplateData.ImageId"),
+			},
+			{
+				Name:        "instance_type",
+				Description: "The instance type.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LaunchTemplateData.InstanceType"),
+			},
+			{
+				Name:        "key_name",
+				Description: "The name of the key pair.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LaunchTemplateData.KeyName"),
+			},
+			{
+				Name:        "ebs_optimized",
+				Description: "Indicates whether the instance is optimized for Amazon EBS I/O.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("LaunchTemplateData.EbsOptimized"),
+			},
+			{
+				Name:        "disable_api_termination",
+				Description: "If set to true, indicates that the instance cannot be terminated using the Amazon EC2 console, command line tool, or API.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("LaunchTemplateData.DisableApiTermination"),
+			},
+			{
+				Name:        "instance_initiated_shutdown_behavior",
+				Description: "Indicates whether an instance stops or terminates when you initiate shutdown from the instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LaunchTemplateData.InstanceInitiatedShutdownBehavior"),
+			},
+			{
+				Name:        "iam_instance_profile_arn",
+				Description: "The Amazon Resource Name (ARN) of the instance profile.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LaunchTemplateData.IamInstanceProfile.Arn"),
+			},
+			{
+				Name:        "iam_instance_profile_name",
+				Description: "The name of the instance profile.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LaunchTemplateData.IamInstanceProfile.Name"),
+			},
+			{
+				Name:        "monitoring_enabled",
+				Description: "Indicates whether detailed monitoring is enabled.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("LaunchTemplateData.Monitoring.Enabled"),
+			},
+			{
+				Name:        "metadata_endpoint_enabled",
+				Description: "Indicates whether the instance metadata service endpoint is enabled.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(ec2LaunchTemplateMetadataOption, "HttpEndpoint"),
+			},
+			{
+				Name:        "imdsv2_required",
+				Description: "Indicates whether session tokens (IMDSv2) are required to retrieve instance metadata.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(ec2LaunchTemplateMetadataOption, "HttpTokens"),
+			},
+			{
+				Name:        "metadata_hop_limit",
+				Description: "The maximum number of hops that the metadata token can travel.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("LaunchTemplateData.MetadataOptions.HttpPutResponseHopLimit"),
+			},
+			{
+				Name:        "user_data",
+				Description: "The decoded user data, decompressed if it is gzip compressed. Null if the user data is not text. Secrets are redacted if scan_user_data_secrets is enabled in the connection config.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getEc2LaunchTemplateVersionUserData,
+				Transform:   transform.FromField("UserData"),
+			},
+			{
+				Name:        "user_data_secrets",
+				Description: "The type and line number of possible secrets found in the user data. Only set if scan_user_data_secrets is enabled in the connection config.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getEc2LaunchTemplateVersionUserData,
+				Transform:   transform.FromField("Secrets"),
+			},
+			{
+				Name:        "security_group_ids",
+				Description: "The IDs of the security groups.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LaunchTemplateData.SecurityGroupIds"),
+			},
+			{
+				Name:        "security_groups",
+				Description: "The names of the security groups, for a nondefault VPC.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LaunchTemplateData.SecurityGroups"),
+			},
+			{
+				Name:        "block_device_mappings",
+				Description: "The block device mappings.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LaunchTemplateData.BlockDeviceMappings"),
+			},
+			{
+				Name:        "network_interfaces",
+				Description: "The network interfaces.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LaunchTemplateData.NetworkInterfaces"),
+			},
+			{
+				Name:        "metadata_options",
+				Description: "The metadata options for the instance.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LaunchTemplateData.MetadataOptions"),
+			},
+			{
+				Name:        "launch_template_data",
+				Description: "The full launch template data, without the user data, which is available decoded in user_data.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LaunchTemplateData").Transform(ec2LaunchTemplateDataWithoutUserData),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(ec2LaunchTemplateVersionTitle),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getEc2LaunchTemplateVersionAkas,
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+//// BUILD HYDRATE INPUT
+
+func launchTemplateVersionFromKey(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	quals := d.KeyColumnQuals
+	launchTemplateID := quals["launch_template_id"].GetStringValue()
+	versionNumber := quals["version_number"].GetInt64Value()
+	item := &ec2.LaunchTemplateVersion{
+		LaunchTemplateId: &launchTemplateID,
+		VersionNumber:    &versionNumber,
+	}
+	return item, nil
+}
+
+//// LIST FUNCTION
+
+func listEc2LaunchTemplateVersions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	plugin.Logger(ctx).Trace("listEc2LaunchTemplateVersions", "AWS_REGION", region)
+	launchTemplate := h.Item.(*ec2.LaunchTemplate)
+
+	// Create session
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// List call
+	err = svc.DescribeLaunchTemplateVersionsPages(
+		&ec2.DescribeLaunchTemplateVersionsInput{
+			LaunchTemplateId: launchTemplate.LaunchTemplateId,
+		},
+		func(page *ec2.DescribeLaunchTemplateVersionsOutput, isLast bool) bool {
+			for _, version := range page.LaunchTemplateVersions {
+				d.StreamLeafListItem(ctx, version)
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getEc2LaunchTemplateVersion(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getEc2LaunchTemplateVersion")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	version := h.Item.(*ec2.LaunchTemplateVersion)
+
+	// Create session
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: version.LaunchTemplateId,
+		Versions:         []*string{aws.String(strconv.FormatInt(*version.VersionNumber, 10))},
+	}
+
+	op, err := svc.DescribeLaunchTemplateVersions(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(op.LaunchTemplateVersions) > 0 {
+		return op.LaunchTemplateVersions[0], nil
+	}
+	return nil, nil
+}
+
+func getEc2LaunchTemplateVersionUserData(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getEc2LaunchTemplateVersionUserData")
+	version := h.Item.(*ec2.LaunchTemplateVersion)
+
+	if version.LaunchTemplateData == nil {
+		return &userData{}, nil
+	}
+	return getUserData(d, version.LaunchTemplateData.UserData)
+}
+
+func getEc2LaunchTemplateVersionAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getEc2LaunchTemplateVersionAkas")
+	version := h.Item.(*ec2.LaunchTemplateVersion)
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Get data for turbot defined properties
+	akas := []string{"arn:" + commonColumnData.Partition + ":ec2:" + commonColumnData.Region + ":" + commonColumnData.AccountId + ":launch-template/" + *version.LaunchTemplateId + ":" + strconv.FormatInt(*version.VersionNumber, 10)}
+
+	return akas, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// ec2LaunchTemplateMetadataOption returns whether the given metadata option is
+// set to its more restrictive or enabled value
+func ec2LaunchTemplateMetadataOption(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	version := d.HydrateItem.(*ec2.LaunchTemplateVersion)
+	if version.LaunchTemplateData == nil || version.LaunchTemplateData.MetadataOptions == nil {
+		return nil, nil
+	}
+	metadataOptions := version.LaunchTemplateData.MetadataOptions
+
+	switch d.Param.(string) {
+	case "HttpEndpoint":
+		// The endpoint is enabled unless explicitly disabled
+		return types.SafeString(metadataOptions.HttpEndpoint) != ec2.LaunchTemplateInstanceMetadataEndpointStateDisabled, nil
+	case "HttpTokens":
+		return types.SafeString(metadataOptions.HttpTokens) == ec2.LaunchTemplateHttpTokensStateRequired, nil
+	}
+	return nil, nil
+}
+
+// ec2LaunchTemplateDataWithoutUserData drops the raw user data, so that secrets
+// redacted from user_data are not exposed in base64 form
+func ec2LaunchTemplateDataWithoutUserData(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data, ok := d.Value.(*ec2.ResponseLaunchTemplateData)
+	if !ok || data == nil {
+		return nil, nil
+	}
+
+	dataCopy := *data
+	dataCopy.UserData = nil
+	return dataCopy, nil
+}
+
+func ec2LaunchTemplateVersionTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	version := d.HydrateItem.(*ec2.LaunchTemplateVersion)
+	return types.SafeString(version.LaunchTemplateName) + ":" + strconv.FormatInt(*version.VersionNumber, 10), nil
+}
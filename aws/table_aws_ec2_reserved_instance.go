@@ -0,0 +1,377 @@
+package aws
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/turbot/go-kit/helpers"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEc2ReservedInstance(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ec2_reserved_instance",
+		Description: "AWS EC2 Reserved Instance",
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("reserved_instance_id"),
+			ShouldIgnoreError: isNotFoundError([]string{"InvalidParameterValue", "InvalidReservedInstancesId"}),
+			ItemFromKey:       reservedInstanceFromKey,
+			Hydrate:           getEc2ReservedInstance,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listEc2ReservedInstances,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "reserved_instance_id",
+				Description: "The ID of the Reserved Instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ReservedInstancesId"),
+			},
+			{
+				Name:        "instance_type",
+				Description: "The instance type on which the Reserved Instance can be used.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the Reserved Instance purchase (payment-pending | active | payment-failed | retired | queued | queued-deleted).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "instance_count",
+				Description: "The number of reservations purchased.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "scope",
+				Description: "The scope of the Reserved Instance (Region | Availability Zone).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "availability_zone",
+				Description: "The Availability Zone in which the Reserved Instance can be used, for zonal Reserved Instances.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "product_description",
+				Description: "The Reserved Instance product platform description.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "instance_tenancy",
+				Description: "The tenancy of the instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "offering_class",
+				Description: "The offering class of the Reserved Instance (standard | convertible).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "offering_type",
+				Description: "The Reserved Instance offering type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "duration",
+				Description: "The duration of the Reserved Instance, in seconds.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "start",
+				Description: "The date and time the Reserved Instance started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "end",
+				Description: "The time when the Reserved Instance expires.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "currency_code",
+				Description: "The currency of the Reserved Instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "fixed_price",
+				Description: "The purchase price of the Reserved Instance.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "usage_price",
+				Description: "The usage price of the Reserved Instance, per hour.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "recurring_charges",
+				Description: "The recurring charge tag assigned to the resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "utilization_percentage",
+				Description: "The percentage of the purchased hours that were used over the last 30 days, from Cost Explorer. Null if Cost Explorer has no data for the Reserved Instance.",
+				Type:        proto.ColumnType_DOUBLE,
+				Hydrate:     getEc2ReservedInstanceUtilization,
+				Transform:   transform.FromField("UtilizationPercentage"),
+			},
+			{
+				Name:        "purchased_hours",
+				Description: "The number of hours purchased over the last 30 days, from Cost Explorer.",
+				Type:        proto.ColumnType_DOUBLE,
+				Hydrate:     getEc2ReservedInstanceUtilization,
+				Transform:   transform.FromField("PurchasedHours"),
+			},
+			{
+				Name:        "unused_hours",
+				Description: "The number of purchased hours that were not used over the last 30 days, from Cost Explorer.",
+				Type:        proto.ColumnType_DOUBLE,
+				Hydrate:     getEc2ReservedInstanceUtilization,
+				Transform:   transform.FromField("UnusedHours"),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the Reserved Instance.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(getEc2ReservedInstanceTurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ReservedInstancesId"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getEc2ReservedInstanceAkas,
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+// ec2ReservedInstanceUtilizationDays is the period, ending today, over which
+// utilization is reported
+const ec2ReservedInstanceUtilizationDays = 30
+
+// ec2ReservedInstanceUtilizations caches the utilization of each connection,
+// as every Cost Explorer request is charged for
+var ec2ReservedInstanceUtilizations = newTTLCache(time.Hour)
+
+// ec2ReservedInstanceUtilization is the Cost Explorer utilization of a single
+// Reserved Instance
+type ec2ReservedInstanceUtilization struct {
+	UtilizationPercentage *float64
+	PurchasedHours        *float64
+	UnusedHours           *float64
+}
+
+//// BUILD HYDRATE INPUT
+
+func reservedInstanceFromKey(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	quals := d.KeyColumnQuals
+	reservedInstanceID := quals["reserved_instance_id"].GetStringValue()
+	item := &ec2.ReservedInstances{
+		ReservedInstancesId: &reservedInstanceID,
+	}
+	return item, nil
+}
+
+//// LIST FUNCTION
+
+func listEc2ReservedInstances(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	plugin.Logger(ctx).Trace("listEc2ReservedInstances", "AWS_REGION", region)
+
+	// Create session
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// DescribeReservedInstances is not paginated
+	resp, err := svc.DescribeReservedInstances(&ec2.DescribeReservedInstancesInput{})
+	if err != nil {
+		return nil, err
+	}
+	for _, reservedInstance := range resp.ReservedInstances {
+		d.StreamListItem(ctx, reservedInstance)
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getEc2ReservedInstance(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getEc2ReservedInstance")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	reservedInstance := h.Item.(*ec2.ReservedInstances)
+
+	// Create session
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &ec2.DescribeReservedInstancesInput{
+		ReservedInstancesIds: []*string{reservedInstance.ReservedInstancesId},
+	}
+
+	op, err := svc.DescribeReservedInstances(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(op.ReservedInstances) > 0 {
+		return op.ReservedInstances[0], nil
+	}
+	return nil, nil
+}
+
+// getEc2ReservedInstanceUtilization looks the Reserved Instance up in the
+// utilization reported by Cost Explorer, which is loaded once for all the
+// rows of the connection
+func getEc2ReservedInstanceUtilization(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getEc2ReservedInstanceUtilization")
+	reservedInstance := h.Item.(*ec2.ReservedInstances)
+
+	cachedData, err := ec2ReservedInstanceUtilizations.GetOrCreate(pluginCacheKey(d, "ec2ReservedInstanceUtilization"), func() (interface{}, error) {
+		return listEc2ReservedInstanceUtilization(ctx, d)
+	})
+	if err != nil {
+		return nil, err
+	}
+	utilization := cachedData.(map[string]*ec2ReservedInstanceUtilization)
+
+	if item, ok := utilization[*reservedInstance.ReservedInstancesId]; ok {
+		return item, nil
+	}
+	return nil, nil
+}
+
+// listEc2ReservedInstanceUtilization returns the utilization of each EC2
+// Reserved Instance, keyed on the Reserved Instance ID
+func listEc2ReservedInstanceUtilization(ctx context.Context, d *plugin.QueryData) (map[string]*ec2ReservedInstanceUtilization, error) {
+	svc, err := CostExplorerService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	end := time.Now().UTC()
+	start := end.AddDate(0, 0, -ec2ReservedInstanceUtilizationDays)
+	input := &costexplorer.GetReservationUtilizationInput{
+		TimePeriod: &costexplorer.DateInterval{
+			Start: aws.String(start.Format("2006-01-02")),
+			End:   aws.String(end.Format("2006-01-02")),
+		},
+		Filter: &costexplorer.Expression{
+			Dimensions: &costexplorer.DimensionValues{
+				Key:    aws.String(costexplorer.DimensionService),
+				Values: []*string{aws.String("Amazon Elastic Compute Cloud - Compute")},
+			},
+		},
+		GroupBy: []*costexplorer.GroupDefinition{
+			{
+				Type: aws.String(costexplorer.GroupDefinitionTypeDimension),
+				Key:  aws.String(costexplorer.DimensionSubscriptionId),
+			},
+		},
+	}
+
+	utilization := map[string]*ec2ReservedInstanceUtilization{}
+	for {
+		resp, err := svc.GetReservationUtilization(input)
+		if err != nil {
+			// Cost Explorer is not enabled, or the connection may not use it
+			if a, ok := err.(awserr.Error); ok && helpers.StringSliceContains([]string{"AccessDeniedException", "DataUnavailableException"}, a.Code()) {
+				return utilization, nil
+			}
+			return nil, err
+		}
+		for _, period := range resp.UtilizationsByTime {
+			for _, group := range period.Groups {
+				// For EC2, the lease ID is the Reserved Instance ID
+				leaseID := group.Attributes["leaseId"]
+				if leaseID == nil || group.Utilization == nil {
+					continue
+				}
+				utilization[*leaseID] = &ec2ReservedInstanceUtilization{
+					UtilizationPercentage: parseCostExplorerFloat(group.Utilization.UtilizationPercentage),
+					PurchasedHours:        parseCostExplorerFloat(group.Utilization.PurchasedHours),
+					UnusedHours:           parseCostExplorerFloat(group.Utilization.UnusedHours),
+				}
+			}
+		}
+		if resp.NextPageToken == nil {
+			break
+		}
+		input.NextPageToken = resp.NextPageToken
+	}
+
+	return utilization, nil
+}
+
+// parseCostExplorerFloat parses the numbers Cost Explorer returns as strings
+func parseCostExplorerFloat(value *string) *float64 {
+	if value == nil {
+		return nil
+	}
+	f, err := strconv.ParseFloat(*value, 64)
+	if err != nil {
+		return nil
+	}
+	return &f
+}
+
+func getEc2ReservedInstanceAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getEc2ReservedInstanceAkas")
+	reservedInstance := h.Item.(*ec2.ReservedInstances)
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Get data for turbot defined properties
+	akas := []string{"arn:" + commonColumnData.Partition + ":ec2:" + commonColumnData.Region + ":" + commonColumnData.AccountId + ":reserved-instances/" + *reservedInstance.ReservedInstancesId}
+
+	return akas, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func getEc2ReservedInstanceTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	reservedInstance := d.HydrateItem.(*ec2.ReservedInstances)
+	return ec2TagsToMap(reservedInstance.Tags)
+}
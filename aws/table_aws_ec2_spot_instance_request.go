@@ -0,0 +1,248 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEc2SpotInstanceRequest(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ec2_spot_instance_request",
+		Description: "AWS EC2 Spot Instance Request",
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("spot_instance_request_id"),
+			ShouldIgnoreError: isNotFoundError([]string{"InvalidSpotInstanceRequestID.Malformed", "InvalidSpotInstanceRequestID.NotFound"}),
+			ItemFromKey:       spotInstanceRequestFromKey,
+			Hydrate:           getEc2SpotInstanceRequest,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listEc2SpotInstanceRequests,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "spot_instance_request_id",
+				Description: "The ID of the Spot Instance request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the Spot Instance request (open | active | closed | cancelled | failed).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_code",
+				Description: "The status code of the Spot Instance request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Status.Code"),
+			},
+			{
+				Name:        "status_message",
+				Description: "The description of the status code.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Status.Message"),
+			},
+			{
+				Name:        "type",
+				Description: "The Spot Instance request type (one-time | persistent).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "instance_id",
+				Description: "The instance ID, if an instance has been launched to fulfill the Spot Instance request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "instance_type",
+				Description: "The instance type requested.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LaunchSpecification.InstanceType"),
+			},
+			{
+				Name:        "spot_price",
+				Description: "The maximum price per hour that you are willing to pay for a Spot Instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "product_description",
+				Description: "The product description associated with the Spot Instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "launched_availability_zone",
+				Description: "The Availability Zone in which the request is launched.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "instance_interruption_behavior",
+				Description: "The behavior when a Spot Instance is interrupted (hibernate | stop | terminate).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The date and time when the Spot Instance request was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "valid_from",
+				Description: "The start date of the request.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "valid_until",
+				Description: "The end date of the request.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "availability_zone_group",
+				Description: "The Availability Zone group. If you specify the same Availability Zone group for all Spot Instance requests, all Spot Instances are launched in the same Availability Zone.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "launch_group",
+				Description: "The instance launch group. Launch groups are Spot Instances that launch together and terminate together.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "fault",
+				Description: "The fault codes for the Spot Instance request, if any.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "launch_specification",
+				Description: "Additional information for launching instances.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the Spot Instance request.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(getEc2SpotInstanceRequestTurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SpotInstanceRequestId"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getEc2SpotInstanceRequestAkas,
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+//// BUILD HYDRATE INPUT
+
+func spotInstanceRequestFromKey(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	quals := d.KeyColumnQuals
+	spotInstanceRequestID := quals["spot_instance_request_id"].GetStringValue()
+	item := &ec2.SpotInstanceRequest{
+		SpotInstanceRequestId: &spotInstanceRequestID,
+	}
+	return item, nil
+}
+
+//// LIST FUNCTION
+
+func listEc2SpotInstanceRequests(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	plugin.Logger(ctx).Trace("listEc2SpotInstanceRequests", "AWS_REGION", region)
+
+	// Create session
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// List call
+	err = svc.DescribeSpotInstanceRequestsPages(
+		&ec2.DescribeSpotInstanceRequestsInput{},
+		func(page *ec2.DescribeSpotInstanceRequestsOutput, isLast bool) bool {
+			for _, request := range page.SpotInstanceRequests {
+				d.StreamListItem(ctx, request)
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getEc2SpotInstanceRequest(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getEc2SpotInstanceRequest")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	request := h.Item.(*ec2.SpotInstanceRequest)
+
+	// Create session
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &ec2.DescribeSpotInstanceRequestsInput{
+		SpotInstanceRequestIds: []*string{request.SpotInstanceRequestId},
+	}
+
+	op, err := svc.DescribeSpotInstanceRequests(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(op.SpotInstanceRequests) > 0 {
+		return op.SpotInstanceRequests[0], nil
+	}
+	return nil, nil
+}
+
+func getEc2SpotInstanceRequestAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getEc2SpotInstanceRequestAkas")
+	request := h.Item.(*ec2.SpotInstanceRequest)
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Get data for turbot defined properties
+	akas := []string{"arn:" + commonColumnData.Partition + ":ec2:" + commonColumnData.Region + ":" + commonColumnData.AccountId + ":spot-instances-request/" + *request.SpotInstanceRequestId}
+
+	return akas, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func getEc2SpotInstanceRequestTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	request := d.HydrateItem.(*ec2.SpotInstanceRequest)
+	return ec2TagsToMap(request.Tags)
+}
@@ -0,0 +1,96 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEc2SpotPrice(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ec2_spot_price",
+		Description: "AWS EC2 Spot Price History",
+		List: &plugin.ListConfig{
+			Hydrate: listEc2SpotPrices,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "availability_zone",
+				Description: "The Availability Zone.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "instance_type",
+				Description: "The instance type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "product_description",
+				Description: "A general description of the AMI (Linux/UNIX | Linux/UNIX (Amazon VPC) | Windows | Windows (Amazon VPC)).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "spot_price",
+				Description: "The Spot price, in USD per hour, at the time of the change.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("SpotPrice").Transform(transform.ToDouble),
+			},
+			{
+				Name:        "create_timestamp",
+				Description: "The date and time the Spot price changed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Timestamp"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEc2SpotPrices(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	plugin.Logger(ctx).Trace("listEc2SpotPrices", "AWS_REGION", region)
+
+	// Create session
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// The history is large, so push down any filters given in the query
+	input := &ec2.DescribeSpotPriceHistoryInput{
+		AvailabilityZone: getQualsStringValue(d, "availability_zone"),
+	}
+	if instanceType := getQualsStringValue(d, "instance_type"); instanceType != nil {
+		input.InstanceTypes = []*string{instanceType}
+	}
+	if productDescription := getQualsStringValue(d, "product_description"); productDescription != nil {
+		input.ProductDescriptions = []*string{productDescription}
+	}
+	input.StartTime, input.EndTime = getQualsTimeRange(d, "create_timestamp", time.Second)
+
+	// List call
+	err = svc.DescribeSpotPriceHistoryPages(
+		input,
+		func(page *ec2.DescribeSpotPriceHistoryOutput, isLast bool) bool {
+			for _, price := range page.SpotPriceHistory {
+				d.StreamListItem(ctx, price)
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
@@ -0,0 +1,145 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsLambdaCodeSigningConfig(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_lambda_code_signing_config",
+		Description: "AWS Lambda Code Signing Config",
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("arn"),
+			ShouldIgnoreError: isNotFoundError([]string{"ResourceNotFoundException"}),
+			Hydrate:           getLambdaCodeSigningConfig,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listLambdaCodeSigningConfigs,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "code_signing_config_id",
+				Description: "The unique identifier of the code signing configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the code signing configuration.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CodeSigningConfigArn"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the code signing configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "untrusted_artifact_on_deployment",
+				Description: "What happens when code that fails the signature checks is deployed, either Warn or Enforce.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CodeSigningPolicies.UntrustedArtifactOnDeployment"),
+			},
+			{
+				Name:        "allowed_publishers",
+				Description: "The signing profile version ARNs that are allowed to sign code.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AllowedPublishers.SigningProfileVersionArns"),
+			},
+			{
+				Name:        "last_modified",
+				Description: "The date and time that the code signing configuration was last modified.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CodeSigningConfigId"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CodeSigningConfigArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listLambdaCodeSigningConfigs(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	plugin.Logger(ctx).Trace("listLambdaCodeSigningConfigs", "AWS_REGION", region)
+
+	// Create service
+	svc, err := LambdaService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	err = svc.ListCodeSigningConfigsPages(
+		&lambda.ListCodeSigningConfigsInput{},
+		func(page *lambda.ListCodeSigningConfigsOutput, lastPage bool) bool {
+			for _, config := range page.CodeSigningConfigs {
+				d.StreamListItem(ctx, config)
+			}
+			return !lastPage
+		},
+	)
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getLambdaCodeSigningConfig(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getLambdaCodeSigningConfig")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	configArn := d.KeyColumnQuals["arn"].GetStringValue()
+
+	// Only look the configuration up in the region of its ARN
+	parsedArn, err := arn.Parse(configArn)
+	if err != nil || parsedArn.Region != region {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := LambdaService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &lambda.GetCodeSigningConfigInput{
+		CodeSigningConfigArn: aws.String(configArn),
+	}
+
+	op, err := svc.GetCodeSigningConfig(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return op.CodeSigningConfig, nil
+}
@@ -0,0 +1,260 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsLambdaEventSourceMapping(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_lambda_event_source_mapping",
+		Description: "AWS Lambda Event Source Mapping",
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("uuid"),
+			ShouldIgnoreError: isNotFoundError([]string{"ResourceNotFoundException"}),
+			Hydrate:           getLambdaEventSourceMapping,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listLambdaEventSourceMappings,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "uuid",
+				Description: "The identifier of the event source mapping.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UUID"),
+			},
+			{
+				Name:        "function_name",
+				Description: "The name of the Lambda function the event source mapping invokes.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FunctionArn").Transform(lambdaFunctionArnToName),
+			},
+			{
+				Name:        "function_arn",
+				Description: "The ARN of the Lambda function the event source mapping invokes, including the version or alias if one is used.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "event_source_arn",
+				Description: "The Amazon Resource Name (ARN) of the event source, such as an SQS queue, Kinesis stream or DynamoDB stream.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the event source mapping, such as Enabled, Disabled or Creating.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state_transition_reason",
+				Description: "Indicates whether a user or Lambda made the last change to the event source mapping.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_modified",
+				Description: "The date that the event source mapping was last updated or that its state changed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_processing_result",
+				Description: "The result of the last Lambda invocation of the function.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "batch_size",
+				Description: "The maximum number of records in each batch that Lambda pulls from the event source.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "maximum_batching_window_in_seconds",
+				Description: "The maximum amount of time, in seconds, that Lambda spends gathering records before invoking the function.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "starting_position",
+				Description: "The position in a stream from which to start reading, either TRIM_HORIZON, LATEST or AT_TIMESTAMP.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "starting_position_timestamp",
+				Description: "The time from which to start reading when the starting position is AT_TIMESTAMP.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "parallelization_factor",
+				Description: "The number of batches to process concurrently from each shard of a stream.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "maximum_record_age_in_seconds",
+				Description: "Discard records from a stream older than the specified age. -1 means records are never discarded.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "maximum_retry_attempts",
+				Description: "Discard records from a stream after the specified number of retries. -1 means failed records are retried until they expire.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "bisect_batch_on_function_error",
+				Description: "If the function returns an error, split a stream batch in two and retry.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "tumbling_window_in_seconds",
+				Description: "The duration in seconds of a processing window for stream sources.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "function_response_types",
+				Description: "A list of current response type enums applied to the event source mapping, such as ReportBatchItemFailures.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "destination_config",
+				Description: "The destination for discarded records from a stream.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "filter_criteria",
+				Description: "The filters that determine which events are sent to the function.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "queues",
+				Description: "The names of the Amazon MQ broker destination queues to consume.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "topics",
+				Description: "The names of the Kafka topics to consume.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "self_managed_event_source",
+				Description: "The self-managed Apache Kafka cluster of the event source.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "source_access_configurations",
+				Description: "The authentication protocols or VPC components used to secure the event source.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UUID"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listLambdaEventSourceMappings(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	plugin.Logger(ctx).Trace("listLambdaEventSourceMappings", "AWS_REGION", region)
+
+	// Create service
+	svc, err := LambdaService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &lambda.ListEventSourceMappingsInput{
+		FunctionName:   getQualsStringValue(d, "function_name"),
+		EventSourceArn: getQualsStringValue(d, "event_source_arn"),
+	}
+
+	err = svc.ListEventSourceMappingsPages(
+		input,
+		func(page *lambda.ListEventSourceMappingsOutput, lastPage bool) bool {
+			for _, mapping := range page.EventSourceMappings {
+				d.StreamListItem(ctx, mapping)
+			}
+			return !lastPage
+		},
+	)
+	if err != nil {
+		// The function in the function_name qual may not exist
+		if a, ok := err.(awserr.Error); ok && a.Code() == lambda.ErrCodeResourceNotFoundException {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getLambdaEventSourceMapping(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getLambdaEventSourceMapping")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	uuid := d.KeyColumnQuals["uuid"].GetStringValue()
+
+	// Create Session
+	svc, err := LambdaService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &lambda.GetEventSourceMappingInput{
+		UUID: aws.String(uuid),
+	}
+
+	op, err := svc.GetEventSourceMapping(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// lambdaFunctionArnToName returns the function name from a function ARN,
+// which may end with a version or alias
+func lambdaFunctionArnToName(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	arn := types.SafeString(d.Value)
+	parts := strings.Split(arn, ":")
+	if len(parts) < 7 {
+		return nil, nil
+	}
+	return parts[6], nil
+}
+
+// lambdaFunctionArnToQualifier returns the version or alias at the end of a
+// function ARN, if it has one
+func lambdaFunctionArnToQualifier(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	arn := types.SafeString(d.Value)
+	parts := strings.Split(arn, ":")
+	if len(parts) < 8 {
+		return nil, nil
+	}
+	return parts[7], nil
+}
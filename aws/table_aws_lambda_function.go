@@ -3,6 +3,7 @@ package aws
 import (
 	"context"
 
+	"github.com/turbot/go-kit/helpers"
 	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
@@ -144,6 +145,25 @@ func tableAwsLambdaFunction(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("VpcConfig.SubnetIds"),
 			},
+			{
+				Name:        "layers",
+				Description: "The layers of the Lambda function",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "reserved_concurrent_executions",
+				Description: "The number of concurrent executions reserved for the Lambda function",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getFunctionTagging,
+				Transform:   transform.FromField("Concurrency.ReservedConcurrentExecutions"),
+			},
+			{
+				Name:        "code_signing_config_arn",
+				Description: "The ARN of the code signing configuration of the Lambda function",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getFunctionCodeSigningConfig,
+				Transform:   transform.FromField("CodeSigningConfigArn").Transform(transform.NullIfZeroValue),
+			},
 			{
 				Name:        "policy",
 				Description: "The resource-based iam policy of Lambda function",
@@ -321,3 +341,35 @@ func getFunctionTagging(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 	}
 	return op, nil
 }
+
+func getFunctionCodeSigningConfig(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getFunctionCodeSigningConfig")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	function := h.Item.(*lambda.FunctionConfiguration)
+
+	// Create Session
+	svc, err := LambdaService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &lambda.GetFunctionCodeSigningConfigInput{
+		FunctionName: function.FunctionName,
+	}
+
+	op, err := svc.GetFunctionCodeSigningConfig(input)
+	if err != nil {
+		// The function may have been deleted since it was listed, or the
+		// caller may not be allowed to read its code signing config
+		if awsErr, ok := err.(awserr.Error); ok && helpers.StringSliceContains([]string{"ResourceNotFoundException", "AccessDeniedException"}, awsErr.Code()) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return op, nil
+}
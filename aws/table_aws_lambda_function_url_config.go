@@ -0,0 +1,116 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsLambdaFunctionURLConfig(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_lambda_function_url_config",
+		Description: "AWS Lambda Function URL Config",
+		List: &plugin.ListConfig{
+			ParentHydrate: listAwsLambdaFunctions,
+			Hydrate:       listLambdaFunctionURLConfigs,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "function_name",
+				Description: "The name of the function.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "function_arn",
+				Description: "The Amazon Resource Name (ARN) of the function, or of the alias the URL invokes.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "qualifier",
+				Description: "The alias name the URL invokes, if any.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FunctionArn").Transform(lambdaFunctionArnToQualifier),
+			},
+			{
+				Name:        "function_url",
+				Description: "The HTTP URL endpoint for the function.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "auth_type",
+				Description: "The type of authentication that the function URL uses, either AWS_IAM or NONE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cors",
+				Description: "The cross-origin resource sharing (CORS) settings of the function URL.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "creation_time",
+				Description: "When the function URL was created.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_modified_time",
+				Description: "When the function URL configuration was last updated.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FunctionUrl"),
+			},
+		}),
+	}
+}
+
+type lambdaFunctionURLConfigInfo struct {
+	FunctionName *string
+	*lambda.FunctionUrlConfig
+}
+
+//// LIST FUNCTION
+
+func listLambdaFunctionURLConfigs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	function := h.Item.(*lambda.FunctionConfiguration)
+	plugin.Logger(ctx).Trace("listLambdaFunctionURLConfigs", "AWS_REGION", region, "FunctionName", *function.FunctionName)
+
+	// Skip the functions the query does not ask for
+	if name := getQualsStringValue(d, "function_name"); name != nil && *name != *function.FunctionName {
+		return nil, nil
+	}
+
+	// Create service
+	svc, err := LambdaService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	err = svc.ListFunctionUrlConfigsPages(
+		&lambda.ListFunctionUrlConfigsInput{FunctionName: function.FunctionName},
+		func(page *lambda.ListFunctionUrlConfigsOutput, lastPage bool) bool {
+			for _, config := range page.FunctionUrlConfigs {
+				d.StreamLeafListItem(ctx, &lambdaFunctionURLConfigInfo{function.FunctionName, config})
+			}
+			return !lastPage
+		},
+	)
+
+	return nil, err
+}
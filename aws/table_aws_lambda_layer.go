@@ -0,0 +1,121 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsLambdaLayer(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_lambda_layer",
+		Description: "AWS Lambda Layer",
+		List: &plugin.ListConfig{
+			Hydrate: listLambdaLayers,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "layer_name",
+				Description: "The name of the layer.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "layer_arn",
+				Description: "The Amazon Resource Name (ARN) of the layer.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "latest_version",
+				Description: "The version number of the newest version of the layer.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("LatestMatchingVersion.Version"),
+			},
+			{
+				Name:        "latest_version_arn",
+				Description: "The ARN of the newest version of the layer.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LatestMatchingVersion.LayerVersionArn"),
+			},
+			{
+				Name:        "created_date",
+				Description: "The date that the newest version of the layer was created.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LatestMatchingVersion.CreatedDate"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the newest version of the layer.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LatestMatchingVersion.Description"),
+			},
+			{
+				Name:        "license_info",
+				Description: "The open source license of the newest version of the layer.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LatestMatchingVersion.LicenseInfo"),
+			},
+			{
+				Name:        "compatible_runtimes",
+				Description: "The runtimes compatible with the newest version of the layer.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LatestMatchingVersion.CompatibleRuntimes"),
+			},
+			{
+				Name:        "compatible_architectures",
+				Description: "The instruction set architectures compatible with the newest version of the layer.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LatestMatchingVersion.CompatibleArchitectures"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LayerName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LayerArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listLambdaLayers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	plugin.Logger(ctx).Trace("listLambdaLayers", "AWS_REGION", region)
+
+	// Create service
+	svc, err := LambdaService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	err = svc.ListLayersPages(
+		&lambda.ListLayersInput{},
+		func(page *lambda.ListLayersOutput, lastPage bool) bool {
+			for _, layer := range page.Layers {
+				d.StreamListItem(ctx, layer)
+			}
+			return !lastPage
+		},
+	)
+
+	return nil, err
+}
@@ -0,0 +1,274 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsLambdaLayerVersion(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_lambda_layer_version",
+		Description: "AWS Lambda Layer Version",
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.AllColumns([]string{"layer_name", "version"}),
+			ShouldIgnoreError: isNotFoundError([]string{"ResourceNotFoundException"}),
+			Hydrate:           getLambdaLayerVersion,
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listLambdaLayers,
+			Hydrate:       listLambdaLayerVersions,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "layer_name",
+				Description: "The name of the layer.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "version",
+				Description: "The version number of the layer version.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "layer_version_arn",
+				Description: "The ARN of the layer version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_date",
+				Description: "The date that the layer version was created.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the layer version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "license_info",
+				Description: "The open source license of the layer version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "compatible_runtimes",
+				Description: "The runtimes compatible with the layer version.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "compatible_architectures",
+				Description: "The instruction set architectures compatible with the layer version.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "code_sha_256",
+				Description: "The SHA-256 hash of the layer archive.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getLambdaLayerVersionContent,
+				Transform:   transform.FromField("CodeSha256"),
+			},
+			{
+				Name:        "code_size",
+				Description: "The size of the layer archive in bytes.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getLambdaLayerVersionContent,
+			},
+			{
+				Name:        "signing_profile_version_arn",
+				Description: "The ARN of the signing profile version used to sign the layer archive.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getLambdaLayerVersionContent,
+			},
+			{
+				Name:        "policy",
+				Description: "The resource-based iam policy of the layer version.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getLambdaLayerVersionPolicy,
+				Transform:   transform.FromField("Policy").Transform(transform.UnmarshalYAML),
+			},
+			{
+				Name:        "policy_std",
+				Description: "Contains the policy in a canonical form for easier searching.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getLambdaLayerVersionPolicy,
+				Transform:   transform.FromField("Policy").Transform(unescape).Transform(policyToCanonical),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(lambdaLayerVersionTitle),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LayerVersionArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+type lambdaLayerVersionInfo struct {
+	LayerName *string
+	*lambda.LayerVersionsListItem
+}
+
+//// LIST FUNCTION
+
+func listLambdaLayerVersions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	layer := h.Item.(*lambda.LayersListItem)
+	plugin.Logger(ctx).Trace("listLambdaLayerVersions", "AWS_REGION", region, "LayerName", *layer.LayerName)
+
+	// Skip the layers the query does not ask for
+	if name := getQualsStringValue(d, "layer_name"); name != nil && *name != *layer.LayerName {
+		return nil, nil
+	}
+
+	// Create service
+	svc, err := LambdaService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	err = svc.ListLayerVersionsPages(
+		&lambda.ListLayerVersionsInput{LayerName: layer.LayerName},
+		func(page *lambda.ListLayerVersionsOutput, lastPage bool) bool {
+			for _, version := range page.LayerVersions {
+				d.StreamLeafListItem(ctx, &lambdaLayerVersionInfo{layer.LayerName, version})
+			}
+			return !lastPage
+		},
+	)
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getLambdaLayerVersion(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getLambdaLayerVersion")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	layerName := d.KeyColumnQuals["layer_name"].GetStringValue()
+	version := d.KeyColumnQuals["version"].GetInt64Value()
+
+	// Create Session
+	svc, err := LambdaService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &lambda.GetLayerVersionInput{
+		LayerName:     aws.String(layerName),
+		VersionNumber: aws.Int64(version),
+	}
+
+	op, err := svc.GetLayerVersion(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lambdaLayerVersionInfo{
+		LayerName: aws.String(layerName),
+		LayerVersionsListItem: &lambda.LayerVersionsListItem{
+			CompatibleArchitectures: op.CompatibleArchitectures,
+			CompatibleRuntimes:      op.CompatibleRuntimes,
+			CreatedDate:             op.CreatedDate,
+			Description:             op.Description,
+			LayerVersionArn:         op.LayerVersionArn,
+			LicenseInfo:             op.LicenseInfo,
+			Version:                 op.Version,
+		},
+	}, nil
+}
+
+func getLambdaLayerVersionContent(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getLambdaLayerVersionContent")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	layerVersion := h.Item.(*lambdaLayerVersionInfo)
+
+	// Create Session
+	svc, err := LambdaService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &lambda.GetLayerVersionInput{
+		LayerName:     layerVersion.LayerName,
+		VersionNumber: layerVersion.Version,
+	}
+
+	op, err := svc.GetLayerVersion(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return op.Content, nil
+}
+
+func getLambdaLayerVersionPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getLambdaLayerVersionPolicy")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	layerVersion := h.Item.(*lambdaLayerVersionInfo)
+
+	// Create Session
+	svc, err := LambdaService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &lambda.GetLayerVersionPolicyInput{
+		LayerName:     layerVersion.LayerName,
+		VersionNumber: layerVersion.Version,
+	}
+
+	op, err := svc.GetLayerVersionPolicy(params)
+	if err != nil {
+		// Layer versions without a policy are only usable by their own account
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "ResourceNotFoundException" {
+			return &lambda.GetLayerVersionPolicyOutput{}, nil
+		}
+		return nil, err
+	}
+
+	return op, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func lambdaLayerVersionTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	layerVersion := d.HydrateItem.(*lambdaLayerVersionInfo)
+	return fmt.Sprintf("%s:%d", aws.StringValue(layerVersion.LayerName), aws.Int64Value(layerVersion.Version)), nil
+}
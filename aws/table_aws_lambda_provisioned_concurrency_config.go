@@ -0,0 +1,131 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsLambdaProvisionedConcurrencyConfig(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_lambda_provisioned_concurrency_config",
+		Description: "AWS Lambda Provisioned Concurrency Config",
+		List: &plugin.ListConfig{
+			ParentHydrate: listAwsLambdaFunctions,
+			Hydrate:       listLambdaProvisionedConcurrencyConfigs,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "function_name",
+				Description: "The name of the function.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "function_arn",
+				Description: "The Amazon Resource Name (ARN) of the alias or version the configuration applies to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "qualifier",
+				Description: "The version number or alias name the configuration applies to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FunctionArn").Transform(lambdaFunctionArnToQualifier),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the allocation process, either IN_PROGRESS, READY or FAILED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_reason",
+				Description: "For failed allocations, the reason that provisioned concurrency could not be allocated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "requested_provisioned_concurrent_executions",
+				Description: "The amount of provisioned concurrency requested.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "allocated_provisioned_concurrent_executions",
+				Description: "The amount of provisioned concurrency allocated.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "available_provisioned_concurrent_executions",
+				Description: "The amount of provisioned concurrency available.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "last_modified",
+				Description: "The date and time that a user last updated the configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(lambdaProvisionedConcurrencyConfigTitle),
+			},
+		}),
+	}
+}
+
+type lambdaProvisionedConcurrencyConfigInfo struct {
+	FunctionName *string
+	*lambda.ProvisionedConcurrencyConfigListItem
+}
+
+//// LIST FUNCTION
+
+func listLambdaProvisionedConcurrencyConfigs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	function := h.Item.(*lambda.FunctionConfiguration)
+	plugin.Logger(ctx).Trace("listLambdaProvisionedConcurrencyConfigs", "AWS_REGION", region, "FunctionName", *function.FunctionName)
+
+	// Skip the functions the query does not ask for
+	if name := getQualsStringValue(d, "function_name"); name != nil && *name != *function.FunctionName {
+		return nil, nil
+	}
+
+	// Create service
+	svc, err := LambdaService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	err = svc.ListProvisionedConcurrencyConfigsPages(
+		&lambda.ListProvisionedConcurrencyConfigsInput{FunctionName: function.FunctionName},
+		func(page *lambda.ListProvisionedConcurrencyConfigsOutput, lastPage bool) bool {
+			for _, config := range page.ProvisionedConcurrencyConfigs {
+				d.StreamLeafListItem(ctx, &lambdaProvisionedConcurrencyConfigInfo{function.FunctionName, config})
+			}
+			return !lastPage
+		},
+	)
+
+	return nil, err
+}
+
+//// TRANSFORM FUNCTIONS
+
+func lambdaProvisionedConcurrencyConfigTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	config := d.HydrateItem.(*lambdaProvisionedConcurrencyConfigInfo)
+	functionArn := aws.StringValue(config.FunctionArn)
+	return aws.StringValue(config.FunctionName) + functionArn[strings.LastIndex(functionArn, ":"):], nil
+}
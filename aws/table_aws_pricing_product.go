@@ -0,0 +1,323 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pricing"
+
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsPricingProduct(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_pricing_product",
+		Description: "AWS Pricing Product",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.SingleColumn("service_code"),
+			Hydrate:    listPricingProducts,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "service_code",
+				Description: "The code for the service the product belongs to, for example AmazonEC2 or AmazonRDS.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "sku",
+				Description: "The unique identifier of the product.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "product_family",
+				Description: "The product family, for example Compute Instance or Database Instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "region",
+				Description: "The code of the region the product is offered in.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(pricingProductAttribute, "regionCode"),
+			},
+			{
+				Name:        "location",
+				Description: "The descriptive name of the location the product is offered in.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(pricingProductAttribute, "location"),
+			},
+			{
+				Name:        "instance_type",
+				Description: "The instance type of the product, for instance based products.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(pricingProductAttribute, "instanceType"),
+			},
+			{
+				Name:        "operating_system",
+				Description: "The operating system of the product, for example Linux or Windows.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(pricingProductAttribute, "operatingSystem"),
+			},
+			{
+				Name:        "tenancy",
+				Description: "The tenancy of the product (Shared | Dedicated | Host).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(pricingProductAttribute, "tenancy"),
+			},
+			{
+				Name:        "usage_type",
+				Description: "The usage type the product is billed under.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(pricingProductAttribute, "usagetype"),
+			},
+			{
+				Name:        "on_demand_hourly_usd",
+				Description: "The On-Demand price of the product, in USD per hour, if it is billed hourly.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "publication_date",
+				Description: "The date and time the price list entry was published.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "attributes",
+				Description: "All the attributes of the product.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "terms",
+				Description: "The On-Demand and Reserved pricing terms of the product.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Sku"),
+			},
+		},
+	}
+}
+
+// pricingProductInfo is a decoded entry of the price list
+type pricingProductInfo struct {
+	ServiceCode       string
+	Sku               string
+	ProductFamily     string
+	OnDemandHourlyUsd *float64
+	PublicationDate   *time.Time
+	Attributes        map[string]interface{}
+	Terms             map[string]interface{}
+}
+
+// The product attributes that can be pushed down to GetProducts, keyed by column name
+var pricingProductFilterColumns = map[string]string{
+	"region":           "regionCode",
+	"instance_type":    "instanceType",
+	"operating_system": "operatingSystem",
+	"tenancy":          "tenancy",
+}
+
+//// LIST FUNCTION
+
+func listPricingProducts(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listPricingProducts")
+
+	// Create session
+	svc, err := PricingService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// The price lists are very large, so push down any filters given in the query
+	input := &pricing.GetProductsInput{
+		ServiceCode:   aws.String(d.KeyColumnQuals["service_code"].GetStringValue()),
+		FormatVersion: aws.String("aws_v1"),
+	}
+	for column, field := range pricingProductFilterColumns {
+		if value := getQualsStringValue(d, column); value != nil {
+			input.Filters = append(input.Filters, &pricing.Filter{
+				Type:  aws.String(pricing.FilterTypeTermMatch),
+				Field: aws.String(field),
+				Value: value,
+			})
+		}
+	}
+
+	// List call
+	err = svc.GetProductsPages(
+		input,
+		func(page *pricing.GetProductsOutput, isLast bool) bool {
+			for _, item := range page.PriceList {
+				d.StreamListItem(ctx, newPricingProductInfo(item))
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+// onDemandPrices caches the prices looked up for the price columns of other
+// tables, as many rows share the same attributes and prices rarely change
+var onDemandPrices = newTTLCache(time.Hour)
+
+// getOnDemandHourlyUsd returns the On-Demand hourly price of the products
+// matching the given attributes, or nil if there is no such product
+func getOnDemandHourlyUsd(ctx context.Context, d *plugin.QueryData, serviceCode string, attributes map[string]string) (interface{}, error) {
+	price := getOnDemandPriceUsd(ctx, d, serviceCode, "Hrs", attributes)
+	if price == nil {
+		return nil, nil
+	}
+	return *price, nil
+}
+
+// getOnDemandPriceUsd returns the On-Demand price per unit of the products
+// matching the given attributes that are billed in that unit. Each price is
+// only looked up once, however many rows ask for it at the same time. The
+// price columns are extras on inventory tables, so failures are logged and
+// give a null price rather than failing the query.
+func getOnDemandPriceUsd(ctx context.Context, d *plugin.QueryData, serviceCode string, unit string, attributes map[string]string) *float64 {
+	filters := make([]string, 0, len(attributes))
+	for field, value := range attributes {
+		filters = append(filters, field+"="+value)
+	}
+	sort.Strings(filters)
+	cacheKey := fmt.Sprintf("onDemandPriceUsd-%s-%s-%s", serviceCode, unit, strings.Join(filters, ","))
+
+	price, err := onDemandPrices.GetOrCreate(pluginCacheKey(d, cacheKey), func() (interface{}, error) {
+		return loadOnDemandPriceUsd(ctx, d, serviceCode, unit, attributes)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("getOnDemandPriceUsd", "service", serviceCode, "filters", strings.Join(filters, ","), "error", err)
+		return nil
+	}
+	return price.(*float64)
+}
+
+// loadOnDemandPriceUsd looks the price up in the Price List. Products with
+// different prices give no price, as the attributes are then not specific
+// enough to pick one.
+func loadOnDemandPriceUsd(ctx context.Context, d *plugin.QueryData, serviceCode string, unit string, attributes map[string]string) (*float64, error) {
+	svc, err := PricingService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &pricing.GetProductsInput{
+		ServiceCode:   aws.String(serviceCode),
+		FormatVersion: aws.String("aws_v1"),
+	}
+	for field, value := range attributes {
+		input.Filters = append(input.Filters, &pricing.Filter{
+			Type:  aws.String(pricing.FilterTypeTermMatch),
+			Field: aws.String(field),
+			Value: aws.String(value),
+		})
+	}
+
+	var priceList []aws.JSONValue
+	err = svc.GetProductsPages(
+		input,
+		func(page *pricing.GetProductsOutput, isLast bool) bool {
+			priceList = append(priceList, page.PriceList...)
+			return !isLast
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	price, err := pricingUniqueOnDemandUsd(priceList, unit)
+	if err != nil {
+		plugin.Logger(ctx).Warn("loadOnDemandPriceUsd", "service", serviceCode, "attributes", attributes, "error", err)
+		return nil, nil
+	}
+
+	return price, nil
+}
+
+// pricingUniqueOnDemandUsd returns the On-Demand price per unit shared by all
+// the price list entries billed in that unit, or nil if none are
+func pricingUniqueOnDemandUsd(priceList []aws.JSONValue, unit string) (*float64, error) {
+	var price *float64
+	for _, item := range priceList {
+		terms, _ := item["terms"].(map[string]interface{})
+		itemPrice := pricingOnDemandUsd(terms, unit)
+		if itemPrice == nil {
+			continue
+		}
+		if price != nil && *price != *itemPrice {
+			return nil, fmt.Errorf("found different On-Demand prices %v and %v", *price, *itemPrice)
+		}
+		price = itemPrice
+	}
+	return price, nil
+}
+
+// newPricingProductInfo decodes a price list entry. Entries are JSON documents
+// of the form {"product": {"sku", "productFamily", "attributes"}, "serviceCode",
+// "terms": {"OnDemand", "Reserved"}, "publicationDate"}.
+func newPricingProductInfo(item aws.JSONValue) *pricingProductInfo {
+	info := &pricingProductInfo{
+		ServiceCode: pricingString(item, "serviceCode"),
+	}
+	if product, ok := item["product"].(map[string]interface{}); ok {
+		info.Sku = pricingString(product, "sku")
+		info.ProductFamily = pricingString(product, "productFamily")
+		info.Attributes, _ = product["attributes"].(map[string]interface{})
+	}
+	if t, err := time.Parse(time.RFC3339, pricingString(item, "publicationDate")); err == nil {
+		info.PublicationDate = &t
+	}
+	info.Terms, _ = item["terms"].(map[string]interface{})
+	info.OnDemandHourlyUsd = pricingOnDemandUsd(info.Terms, "Hrs")
+
+	return info
+}
+
+// pricingOnDemandUsd returns the USD price of the first price dimension of the
+// On-Demand terms that is billed in the given unit
+func pricingOnDemandUsd(terms map[string]interface{}, unit string) *float64 {
+	onDemand, _ := terms["OnDemand"].(map[string]interface{})
+	for _, term := range onDemand {
+		term, _ := term.(map[string]interface{})
+		dimensions, _ := term["priceDimensions"].(map[string]interface{})
+		for _, dimension := range dimensions {
+			dimension, _ := dimension.(map[string]interface{})
+			if pricingString(dimension, "unit") != unit {
+				continue
+			}
+			pricePerUnit, _ := dimension["pricePerUnit"].(map[string]interface{})
+			price, err := strconv.ParseFloat(pricingString(pricePerUnit, "USD"), 64)
+			if err != nil {
+				continue
+			}
+			return &price
+		}
+	}
+	return nil
+}
+
+func pricingString(m map[string]interface{}, key string) string {
+	value, _ := m[key].(string)
+	return value
+}
+
+//// TRANSFORM FUNCTIONS
+
+func pricingProductAttribute(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	product := d.HydrateItem.(*pricingProductInfo)
+	return product.Attributes[d.Param.(string)], nil
+}
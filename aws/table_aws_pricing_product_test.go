@@ -0,0 +1,97 @@
+package aws
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+func TestNewPricingProductInfo(t *testing.T) {
+	priceList := `{
+  "product": {
+    "productFamily": "Compute Instance",
+    "attributes": {"regionCode": "us-east-1", "instanceType": "m5.large", "operatingSystem": "Linux", "tenancy": "Shared"},
+    "sku": "2XRKBPRK3Z6S5B8S"
+  },
+  "serviceCode": "AmazonEC2",
+  "terms": {
+    "OnDemand": {
+      "2XRKBPRK3Z6S5B8S.JRTCKXETXF": {
+        "priceDimensions": {
+          "2XRKBPRK3Z6S5B8S.JRTCKXETXF.6YS6EN2CT7": {"unit": "Hrs", "pricePerUnit": {"USD": "0.0960000000"}}
+        }
+      }
+    }
+  },
+  "publicationDate": "2021-01-07T22:25:53Z"
+}`
+
+	var item aws.JSONValue
+	if err := json.Unmarshal([]byte(priceList), &item); err != nil {
+		t.Fatal(err)
+	}
+
+	info := newPricingProductInfo(item)
+	if info.ServiceCode != "AmazonEC2" || info.Sku != "2XRKBPRK3Z6S5B8S" || info.ProductFamily != "Compute Instance" {
+		t.Errorf("unexpected product: %+v", info)
+	}
+	if info.Attributes["instanceType"] != "m5.large" {
+		t.Errorf("unexpected attributes: %v", info.Attributes)
+	}
+	if info.OnDemandHourlyUsd == nil || *info.OnDemandHourlyUsd != 0.096 {
+		t.Errorf("unexpected on demand hourly price: %v", info.OnDemandHourlyUsd)
+	}
+	if info.PublicationDate == nil || info.PublicationDate.Year() != 2021 {
+		t.Errorf("unexpected publication date: %v", info.PublicationDate)
+	}
+}
+
+func TestNewPricingProductInfoNoHourlyPrice(t *testing.T) {
+	item := aws.JSONValue{
+		"serviceCode": "AmazonS3",
+		"terms": map[string]interface{}{
+			"OnDemand": map[string]interface{}{
+				"term": map[string]interface{}{
+					"priceDimensions": map[string]interface{}{
+						"dimension": map[string]interface{}{"unit": "GB-Mo", "pricePerUnit": map[string]interface{}{"USD": "0.023"}},
+					},
+				},
+			},
+		},
+	}
+
+	if price := newPricingProductInfo(item).OnDemandHourlyUsd; price != nil {
+		t.Errorf("expected no hourly price, got %v", *price)
+	}
+}
+
+func TestPricingUniqueOnDemandUsd(t *testing.T) {
+	entry := func(price string) aws.JSONValue {
+		return aws.JSONValue{
+			"terms": map[string]interface{}{
+				"OnDemand": map[string]interface{}{
+					"term": map[string]interface{}{
+						"priceDimensions": map[string]interface{}{
+							"dimension": map[string]interface{}{"unit": "Hrs", "pricePerUnit": map[string]interface{}{"USD": price}},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	price, err := pricingUniqueOnDemandUsd([]aws.JSONValue{entry("0.26"), entry("0.26")}, "Hrs")
+	if err != nil || price == nil || *price != 0.26 {
+		t.Errorf("expected 0.26, got %v, %v", price, err)
+	}
+
+	price, err = pricingUniqueOnDemandUsd(nil, "Hrs")
+	if err != nil || price != nil {
+		t.Errorf("expected no price, got %v, %v", price, err)
+	}
+
+	if _, err = pricingUniqueOnDemandUsd([]aws.JSONValue{entry("0.26"), entry("0.338")}, "Hrs"); err == nil {
+		t.Error("expected an error for different prices")
+	}
+}
@@ -0,0 +1,150 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRDSCertificate(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_rds_certificate",
+		Description: "AWS RDS Certificate",
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("certificate_identifier"),
+			ShouldIgnoreError: isNotFoundError([]string{"CertificateNotFound"}),
+			Hydrate:           getRDSCertificate,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listRDSCertificates,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "certificate_identifier",
+				Description: "The unique key that identifies the certificate, such as rds-ca-2019.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the certificate.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CertificateArn"),
+			},
+			{
+				Name:        "certificate_type",
+				Description: "The type of the certificate.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "thumbprint",
+				Description: "The thumbprint of the certificate.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "valid_from",
+				Description: "The starting date from which the certificate is valid.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "valid_till",
+				Description: "The final date that the certificate continues to be valid.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "customer_override",
+				Description: "Indicates whether there is an override for the default certificate identifier.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "customer_override_valid_till",
+				Description: "The date at which the override for the default certificate identifier expires.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CertificateIdentifier"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CertificateArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRDSCertificates(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	plugin.Logger(ctx).Trace("listRDSCertificates", "AWS_REGION", region)
+
+	// Create Session
+	svc, err := RDSService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// List call
+	err = svc.DescribeCertificatesPages(
+		&rds.DescribeCertificatesInput{},
+		func(page *rds.DescribeCertificatesOutput, isLast bool) bool {
+			for _, certificate := range page.Certificates {
+				d.StreamListItem(ctx, certificate)
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRDSCertificate(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getRDSCertificate")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	identifier := d.KeyColumnQuals["certificate_identifier"].GetStringValue()
+
+	// Create service
+	svc, err := RDSService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &rds.DescribeCertificatesInput{
+		CertificateIdentifier: aws.String(identifier),
+	}
+
+	op, err := svc.DescribeCertificates(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(op.Certificates) > 0 {
+		return op.Certificates[0], nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,236 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRDSDBEngineVersion(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_rds_db_engine_version",
+		Description: "AWS RDS DB Engine Version",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"engine", "engine_version"}),
+			Hydrate:    getRDSDBEngineVersion,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listRDSDBEngineVersions,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "engine",
+				Description: "The name of the database engine.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "engine_version",
+				Description: "The version number of the database engine.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "major_engine_version",
+				Description: "The major engine version of the DB engine version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the DB engine version, either available or deprecated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "db_parameter_group_family",
+				Description: "The name of the DB parameter group family for the database engine.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBParameterGroupFamily"),
+			},
+			{
+				Name:        "db_engine_description",
+				Description: "The description of the database engine.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBEngineDescription"),
+			},
+			{
+				Name:        "db_engine_version_description",
+				Description: "The description of the database engine version.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBEngineVersionDescription"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of a custom engine version.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBEngineVersionArn"),
+			},
+			{
+				Name:        "create_time",
+				Description: "The creation time of a custom engine version.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "supports_global_databases",
+				Description: "Indicates whether the engine version can be used in an Aurora global database.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "supports_log_exports_to_cloudwatch_logs",
+				Description: "Indicates whether the engine version supports exporting the log types in exportable_log_types to CloudWatch Logs.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "supports_parallel_query",
+				Description: "Indicates whether the engine version can be used with Aurora parallel query.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "supports_read_replica",
+				Description: "Indicates whether the engine version supports read replicas.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "exportable_log_types",
+				Description: "The types of logs that the database engine has available for export to CloudWatch Logs.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "supported_engine_modes",
+				Description: "The engine modes supported by the engine version, such as provisioned or serverless.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "supported_feature_names",
+				Description: "The features supported by the engine version.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "valid_upgrade_targets",
+				Description: "The engine versions that this engine version can be upgraded to.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ValidUpgradeTarget"),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags attached to a custom engine version.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("TagList"),
+			},
+
+			// Standard columns
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(getRDSDBEngineVersionTurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(rdsDBEngineVersionTitle),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRDSDBEngineVersions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	plugin.Logger(ctx).Trace("listRDSDBEngineVersions", "AWS_REGION", region)
+
+	// Create Session
+	svc, err := RDSService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// IncludeAll also returns the deprecated versions that instances may
+	// still be running
+	input := &rds.DescribeDBEngineVersionsInput{
+		Engine:                 getQualsStringValue(d, "engine"),
+		EngineVersion:          getQualsStringValue(d, "engine_version"),
+		DBParameterGroupFamily: getQualsStringValue(d, "db_parameter_group_family"),
+		IncludeAll:             aws.Bool(true),
+	}
+
+	// List call
+	err = svc.DescribeDBEngineVersionsPages(
+		input,
+		func(page *rds.DescribeDBEngineVersionsOutput, isLast bool) bool {
+			for _, engineVersion := range page.DBEngineVersions {
+				d.StreamListItem(ctx, engineVersion)
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRDSDBEngineVersion(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getRDSDBEngineVersion")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	engine := d.KeyColumnQuals["engine"].GetStringValue()
+	engineVersion := d.KeyColumnQuals["engine_version"].GetStringValue()
+
+	// Create service
+	svc, err := RDSService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &rds.DescribeDBEngineVersionsInput{
+		Engine:        aws.String(engine),
+		EngineVersion: aws.String(engineVersion),
+		IncludeAll:    aws.Bool(true),
+	}
+
+	op, err := svc.DescribeDBEngineVersions(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(op.DBEngineVersions) > 0 {
+		return op.DBEngineVersions[0], nil
+	}
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func getRDSDBEngineVersionTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	engineVersion := d.HydrateItem.(*rds.DBEngineVersion)
+
+	if engineVersion.TagList != nil {
+		turbotTagsMap := map[string]string{}
+		for _, i := range engineVersion.TagList {
+			turbotTagsMap[*i.Key] = *i.Value
+		}
+		return turbotTagsMap, nil
+	}
+	return nil, nil
+}
+
+func rdsDBEngineVersionTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	engineVersion := d.HydrateItem.(*rds.DBEngineVersion)
+	return aws.StringValue(engineVersion.Engine) + " " + aws.StringValue(engineVersion.EngineVersion), nil
+}
@@ -0,0 +1,106 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRDSDBEvent(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_rds_db_event",
+		Description: "AWS RDS DB Event",
+		List: &plugin.ListConfig{
+			Hydrate: listRDSDBEvents,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "source_identifier",
+				Description: "The identifier of the source of the event, such as a DB instance identifier.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source_type",
+				Description: "The type of the source of the event, such as db-instance, db-cluster or db-parameter-group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source_arn",
+				Description: "The Amazon Resource Name (ARN) of the source of the event.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "date",
+				Description: "The date and time of the event.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "message",
+				Description: "The text of the event.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "event_categories",
+				Description: "The categories of the event, such as maintenance, failover or configuration change.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Message"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRDSDBEvents(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	plugin.Logger(ctx).Trace("listRDSDBEvents", "AWS_REGION", region)
+
+	// Create Session
+	svc, err := RDSService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// Without a time range the API only returns events from the last hour.
+	// Events are kept for 14 days.
+	input := &rds.DescribeEventsInput{
+		SourceType:       getQualsStringValue(d, "source_type"),
+		SourceIdentifier: getQualsStringValue(d, "source_identifier"),
+	}
+	input.StartTime, input.EndTime = getQualsTimeRange(d, "date", time.Second)
+
+	// A source identifier can only be given with its source type
+	if input.SourceType == nil {
+		input.SourceIdentifier = nil
+	}
+
+	// List call
+	err = svc.DescribeEventsPages(
+		input,
+		func(page *rds.DescribeEventsOutput, isLast bool) bool {
+			for _, event := range page.Events {
+				d.StreamListItem(ctx, event)
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
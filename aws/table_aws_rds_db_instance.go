@@ -2,7 +2,10 @@ package aws
 
 import (
 	"context"
+	"strings"
+	"time"
 
+	"github.com/turbot/go-kit/types"
 	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
 
@@ -389,6 +392,13 @@ func tableAwsRDSDBInstance(_ context.Context) *plugin.Table {
 				Description: "A list of VPC security group elements that the DB instance belongs to",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "on_demand_hourly_usd",
+				Description: "The On-Demand price of the DB instance class for the engine and deployment option of the DB instance, in USD per hour, from the AWS Price List",
+				Type:        proto.ColumnType_DOUBLE,
+				Hydrate:     getRDSDBInstanceOnDemandHourlyUsd,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "tags_src",
 				Description: "A list of tags attached to the DB Instance",
@@ -447,73 +457,33 @@ func listRDSDBInstances(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 		return nil, err
 	}
 
-	var params []*rds.Filter
-	if region == "sa-east-1" {
-		params = []*rds.Filter{
-			{
-				Name: aws.String("engine"),
-				Values: []*string{
-					aws.String("mariadb"),
-					aws.String("mysql"),
-					aws.String("oracle-ee"),
-					aws.String("oracle-se"),
-					aws.String("oracle-se1"),
-					aws.String("oracle-se2"),
-					aws.String("postgres"),
-					aws.String("sqlserver-ee"),
-					aws.String("sqlserver-ex"),
-					aws.String("sqlserver-se"),
-					aws.String("sqlserver-web"),
-				},
-			},
-		}
-	} else if region == "eu-north-1" {
-		params = []*rds.Filter{
-			{
-				Name: aws.String("engine"),
-				Values: []*string{
-					aws.String("mariadb"),
-					aws.String("mysql"),
-					aws.String("oracle-ee"),
-					aws.String("oracle-se"),
-					aws.String("oracle-se1"),
-					aws.String("oracle-se2"),
-					aws.String("postgres"),
-					aws.String("sqlserver-ee"),
-					aws.String("sqlserver-se"),
-					aws.String("sqlserver-web"),
-				},
-			},
-		}
+	// DescribeDBInstances also returns Neptune and DocumentDB instances, so
+	// unless they are included, filter on the RDS engines of the region. An
+	// engine in the query replaces the filter, so asking for a Neptune or
+	// DocumentDB engine lists its instances.
+	var engines []*string
+	if engine := getQualsStringValue(d, "engine"); engine != nil {
+		engines = []*string{engine}
 	} else {
-		params = []*rds.Filter{
-			{
-				Name: aws.String("engine"),
-				Values: []*string{
-					aws.String("aurora"),
-					aws.String("aurora-mysql"),
-					aws.String("aurora-postgresql"),
-					aws.String("mariadb"),
-					aws.String("mysql"),
-					aws.String("oracle-ee"),
-					aws.String("oracle-se"),
-					aws.String("oracle-se1"),
-					aws.String("oracle-se2"),
-					aws.String("postgres"),
-					aws.String("sqlserver-ee"),
-					aws.String("sqlserver-se"),
-					aws.String("sqlserver-ex"),
-					aws.String("sqlserver-web"),
-				},
+		engines, err = getRDSDBInstanceEngines(ctx, d, region)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	input := &rds.DescribeDBInstancesInput{}
+	if len(engines) > 0 {
+		input.Filters = []*rds.Filter{
+			{
+				Name:   aws.String("engine"),
+				Values: engines,
 			},
 		}
 	}
 
 	// List call
 	err = svc.DescribeDBInstancesPages(
-		&rds.DescribeDBInstancesInput{
-			Filters: params,
-		},
+		input,
 		func(page *rds.DescribeDBInstancesOutput, isLast bool) bool {
 			for _, dbInstance := range page.DBInstances {
 				d.StreamListItem(ctx, dbInstance)
@@ -524,6 +494,58 @@ func listRDSDBInstances(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 	return nil, err
 }
 
+// rdsNonRDSEngines are the engines of other services that share the RDS API
+var rdsNonRDSEngines = map[string]bool{
+	"docdb":   true,
+	"neptune": true,
+}
+
+// rdsDBInstanceEngines caches the engines of each region, which rarely change
+var rdsDBInstanceEngines = newTTLCache(time.Hour)
+
+// getRDSDBInstanceEngines returns the engines to list DB instances for in the
+// region, or nil to list instances of every engine. The engines available in
+// each region are discovered from its engine versions, including deprecated
+// ones, so instances of retired engines are still listed.
+func getRDSDBInstanceEngines(ctx context.Context, d *plugin.QueryData, region string) ([]*string, error) {
+	awsConfig := GetConfig(d.Connection)
+	if awsConfig.RDSIncludeNeptuneAndDocDB != nil && *awsConfig.RDSIncludeNeptuneAndDocDB {
+		return nil, nil
+	}
+
+	engines, err := rdsDBInstanceEngines.GetOrCreate(pluginCacheKey(d, "rdsDBInstanceEngines-"+region), func() (interface{}, error) {
+		svc, err := RDSService(ctx, d, region)
+		if err != nil {
+			return nil, err
+		}
+
+		var engines []*string
+		seen := map[string]bool{}
+		err = svc.DescribeDBEngineVersionsPages(
+			&rds.DescribeDBEngineVersionsInput{
+				IncludeAll: aws.Bool(true),
+			},
+			func(page *rds.DescribeDBEngineVersionsOutput, isLast bool) bool {
+				for _, version := range page.DBEngineVersions {
+					engine := aws.StringValue(version.Engine)
+					if seen[engine] || rdsNonRDSEngines[engine] {
+						continue
+					}
+					seen[engine] = true
+					engines = append(engines, version.Engine)
+				}
+				return !isLast
+			},
+		)
+		return engines, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return engines.([]*string), nil
+}
+
 //// HYDRATE FUNCTIONS
 
 func getRDSDBInstance(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
@@ -556,6 +578,72 @@ func getRDSDBInstance(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 	return nil, nil
 }
 
+// The Price List databaseEngine and databaseEdition attributes for each RDS engine
+var rdsPricingEngines = map[string][]string{
+	"aurora":            {"Aurora MySQL"},
+	"aurora-mysql":      {"Aurora MySQL"},
+	"aurora-postgresql": {"Aurora PostgreSQL"},
+	"mariadb":           {"MariaDB"},
+	"mysql":             {"MySQL"},
+	"postgres":          {"PostgreSQL"},
+	"oracle-ee":         {"Oracle", "Enterprise"},
+	"oracle-se":         {"Oracle", "Standard"},
+	"oracle-se1":        {"Oracle", "Standard One"},
+	"oracle-se2":        {"Oracle", "Standard Two"},
+	"sqlserver-ee":      {"SQL Server", "Enterprise"},
+	"sqlserver-se":      {"SQL Server", "Standard"},
+	"sqlserver-ex":      {"SQL Server", "Express"},
+	"sqlserver-web":     {"SQL Server", "Web"},
+}
+
+func getRDSDBInstanceOnDemandHourlyUsd(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getRDSDBInstanceOnDemandHourlyUsd")
+	dbInstance := h.Item.(*rds.DBInstance)
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// The Price List API only covers the commercial partition
+	engine, ok := rdsPricingEngines[types.SafeString(dbInstance.Engine)]
+	if commonColumnData.Partition != "aws" || !ok || dbInstance.DBInstanceClass == nil {
+		return nil, nil
+	}
+
+	attributes := map[string]string{
+		"regionCode":     commonColumnData.Region,
+		"productFamily":  "Database Instance",
+		"instanceType":   *dbInstance.DBInstanceClass,
+		"databaseEngine": engine[0],
+	}
+	if len(engine) > 1 {
+		attributes["databaseEdition"] = engine[1]
+	}
+	// Aurora is priced per instance, whatever the deployment, but I/O-Optimized
+	// clusters pay more for their instances than Aurora Standard ones
+	if strings.HasPrefix(*dbInstance.Engine, "aurora") {
+		attributes["storage"] = "EBS Only"
+		if types.SafeString(dbInstance.StorageType) == "aurora-iopt1" {
+			attributes["storage"] = "Aurora IO Optimization Mode"
+		}
+	} else {
+		attributes["deploymentOption"] = "Single-AZ"
+		if types.BoolValue(dbInstance.MultiAZ) {
+			attributes["deploymentOption"] = "Multi-AZ"
+		}
+	}
+	switch types.SafeString(dbInstance.LicenseModel) {
+	case "license-included":
+		attributes["licenseModel"] = "License included"
+	case "bring-your-own-license":
+		attributes["licenseModel"] = "Bring your own license"
+	}
+
+	return getOnDemandHourlyUsd(ctx, d, "AmazonRDS", attributes)
+}
+
 //// TRANSFORM FUNCTIONS ////
 
 func getRDSDBInstanceTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
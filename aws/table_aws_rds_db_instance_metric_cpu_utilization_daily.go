@@ -0,0 +1,45 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRDSDBInstanceMetricCpuUtilizationDaily(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_rds_db_instance_metric_cpu_utilization_daily",
+		Description: "AWS RDS DB Instance CloudWatch CPU utilization metrics, aggregated daily for the last year",
+		List: &plugin.ListConfig{
+			ParentHydrate: listRDSDBInstances,
+			Hydrate:       listRDSDBInstanceMetricCpuUtilizationDaily,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: cwMetricColumns([]*plugin.Column{
+			{
+				Name:        "db_instance_identifier",
+				Description: "The friendly name to identify the DB Instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DimensionValue"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRDSDBInstanceMetricCpuUtilizationDaily(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	dbInstance := h.Item.(*rds.DBInstance)
+
+	// Skip the instances the query does not ask for
+	if identifier := getQualsStringValue(d, "db_instance_identifier"); identifier != nil && *identifier != *dbInstance.DBInstanceIdentifier {
+		return nil, nil
+	}
+
+	return listCWMetricStatistics(ctx, d, "AWS/RDS", "CPUUtilization", "DBInstanceIdentifier", *dbInstance.DBInstanceIdentifier)
+}
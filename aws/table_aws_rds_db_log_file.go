@@ -0,0 +1,186 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+// maxRDSLogFileDownloadBytes is the size of the largest log file that is
+// downloaded for the log_file_data column
+const maxRDSLogFileDownloadBytes = 10 * 1024 * 1024
+
+//// TABLE DEFINITION
+
+func tableAwsRDSDBLogFile(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_rds_db_log_file",
+		Description: "AWS RDS DB Log File",
+		List: &plugin.ListConfig{
+			ParentHydrate: listRDSDBInstances,
+			Hydrate:       listRDSDBLogFiles,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "db_instance_identifier",
+				Description: "The identifier of the DB instance the log file belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBInstanceIdentifier"),
+			},
+			{
+				Name:        "log_file_name",
+				Description: "The name of the log file.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_written",
+				Description: "The date and time the log file was last written to.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LastWritten").Transform(convertTimestamp),
+			},
+			{
+				Name:        "size",
+				Description: "The size of the log file in bytes.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "log_file_data",
+				Description: "The contents of the log file. Only downloaded when selected and rds_download_log_files is enabled in the connection config, and null for files over 10 MB.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getRDSDBLogFileData,
+				Transform:   transform.FromField("LogFileData"),
+			},
+			{
+				Name:        "log_file_data_truncated",
+				Description: "If true, the log file grew past 10 MB while it was downloaded, and log_file_data only holds its start.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getRDSDBLogFileData,
+				Transform:   transform.FromField("Truncated"),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LogFileName"),
+			},
+		}),
+	}
+}
+
+type rdsDBLogFileInfo struct {
+	DBInstanceIdentifier *string
+	*rds.DescribeDBLogFilesDetails
+}
+
+type rdsDBLogFileData struct {
+	LogFileData *string
+	Truncated   bool
+}
+
+//// LIST FUNCTION
+
+func listRDSDBLogFiles(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	dbInstance := h.Item.(*rds.DBInstance)
+	plugin.Logger(ctx).Trace("listRDSDBLogFiles", "AWS_REGION", region, "DBInstanceIdentifier", *dbInstance.DBInstanceIdentifier)
+
+	// Skip the instances the query does not ask for
+	if identifier := getQualsStringValue(d, "db_instance_identifier"); identifier != nil && *identifier != *dbInstance.DBInstanceIdentifier {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := RDSService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// List call
+	err = svc.DescribeDBLogFilesPages(
+		&rds.DescribeDBLogFilesInput{
+			DBInstanceIdentifier: dbInstance.DBInstanceIdentifier,
+			FilenameContains:     getQualsStringValue(d, "log_file_name"),
+		},
+		func(page *rds.DescribeDBLogFilesOutput, isLast bool) bool {
+			for _, logFile := range page.DescribeDBLogFiles {
+				d.StreamLeafListItem(ctx, &rdsDBLogFileInfo{dbInstance.DBInstanceIdentifier, logFile})
+			}
+			return !isLast
+		},
+	)
+	if err != nil {
+		// The instance may have been deleted since it was listed
+		if a, ok := err.(awserr.Error); ok && a.Code() == rds.ErrCodeDBInstanceNotFoundFault {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRDSDBLogFileData(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getRDSDBLogFileData")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	logFile := h.Item.(*rdsDBLogFileInfo)
+
+	// Log files can hold sensitive data, so are only downloaded when enabled
+	awsConfig := GetConfig(d.Connection)
+	if awsConfig.RDSDownloadLogFiles == nil || !*awsConfig.RDSDownloadLogFiles {
+		return nil, nil
+	}
+
+	if aws.Int64Value(logFile.Size) > maxRDSLogFileDownloadBytes {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := RDSService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// The log file is downloaded in portions of up to 1 MB
+	var data strings.Builder
+	truncated := false
+	err = svc.DownloadDBLogFilePortionPages(
+		&rds.DownloadDBLogFilePortionInput{
+			DBInstanceIdentifier: logFile.DBInstanceIdentifier,
+			LogFileName:          logFile.LogFileName,
+			Marker:               aws.String("0"),
+		},
+		func(page *rds.DownloadDBLogFilePortionOutput, isLast bool) bool {
+			data.WriteString(aws.StringValue(page.LogFileData))
+			// The file may have grown since it was listed
+			if !isLast && data.Len() > maxRDSLogFileDownloadBytes {
+				truncated = true
+				return false
+			}
+			return !isLast
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rdsDBLogFileData{aws.String(data.String()), truncated}, nil
+}
@@ -0,0 +1,155 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRDSDBParameter(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_rds_db_parameter",
+		Description: "AWS RDS DB Parameter",
+		List: &plugin.ListConfig{
+			ParentHydrate: listRDSDBParameterGroups,
+			Hydrate:       listRDSDBParameters,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "db_parameter_group_name",
+				Description: "The name of the DB parameter group the parameter belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBParameterGroupName"),
+			},
+			{
+				Name:        "db_parameter_group_family",
+				Description: "The name of the DB parameter group family of the DB parameter group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBParameterGroupFamily"),
+			},
+			{
+				Name:        "parameter_name",
+				Description: "The name of the parameter.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "parameter_value",
+				Description: "The value of the parameter.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source",
+				Description: "The source of the parameter value, either user, engine-default or system.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "apply_type",
+				Description: "Specifies the engine specific parameters type, either static or dynamic.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "apply_method",
+				Description: "Indicates when to apply parameter updates, either immediate or pending-reboot.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "data_type",
+				Description: "Specifies the valid data type for the parameter.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "allowed_values",
+				Description: "Specifies the valid range of values for the parameter.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_modifiable",
+				Description: "Indicates whether the parameter can be modified.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "minimum_engine_version",
+				Description: "The earliest engine version to which the parameter can apply.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "Provides a description of the parameter.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "supported_engine_modes",
+				Description: "The valid DB engine modes for the parameter.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ParameterName"),
+			},
+		}),
+	}
+}
+
+type rdsDBParameterInfo struct {
+	DBParameterGroupName   *string
+	DBParameterGroupFamily *string
+	*rds.Parameter
+}
+
+//// LIST FUNCTION
+
+func listRDSDBParameters(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	parameterGroup := h.Item.(*rds.DBParameterGroup)
+	plugin.Logger(ctx).Trace("listRDSDBParameters", "AWS_REGION", region, "DBParameterGroupName", *parameterGroup.DBParameterGroupName)
+
+	// Skip the parameter groups the query does not ask for
+	if name := getQualsStringValue(d, "db_parameter_group_name"); name != nil && *name != *parameterGroup.DBParameterGroupName {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := RDSService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// List call
+	err = svc.DescribeDBParametersPages(
+		&rds.DescribeDBParametersInput{
+			DBParameterGroupName: parameterGroup.DBParameterGroupName,
+			Source:               getQualsStringValue(d, "source"),
+		},
+		func(page *rds.DescribeDBParametersOutput, isLast bool) bool {
+			for _, parameter := range page.Parameters {
+				d.StreamLeafListItem(ctx, &rdsDBParameterInfo{parameterGroup.DBParameterGroupName, parameterGroup.DBParameterGroupFamily, parameter})
+			}
+			return !isLast
+		},
+	)
+	if err != nil {
+		// The parameter group may have been deleted since it was listed
+		if a, ok := err.(awserr.Error); ok && a.Code() == rds.ErrCodeDBParameterGroupNotFoundFault {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,244 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRDSDBProxy(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_rds_db_proxy",
+		Description: "AWS RDS DB Proxy",
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("db_proxy_name"),
+			ShouldIgnoreError: isNotFoundError([]string{"DBProxyNotFoundFault"}),
+			Hydrate:           getRDSDBProxy,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listRDSDBProxies,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "db_proxy_name",
+				Description: "The identifier for the proxy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBProxyName"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) for the proxy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBProxyArn"),
+			},
+			{
+				Name:        "status",
+				Description: "The current status of the proxy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "engine_family",
+				Description: "The kinds of databases that the proxy can connect to, either MYSQL or POSTGRESQL.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "endpoint",
+				Description: "The endpoint that you can use to connect to the proxy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "require_tls",
+				Description: "Indicates whether Transport Layer Security (TLS) encryption is required for connections to the proxy.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("RequireTLS"),
+			},
+			{
+				Name:        "debug_logging",
+				Description: "Indicates whether the proxy includes detailed information about SQL statements in its logs.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "idle_client_timeout",
+				Description: "The number of seconds a connection to the proxy can have no activity before the proxy drops the client connection.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "role_arn",
+				Description: "The ARN of the IAM role that the proxy uses to access secrets in Secrets Manager.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vpc_id",
+				Description: "The ID of the VPC of the proxy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_date",
+				Description: "The date and time when the proxy was first created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "updated_date",
+				Description: "The date and time when the proxy was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "auth",
+				Description: "The authentication settings of the proxy, such as the Secrets Manager secrets it uses to connect to the database.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "vpc_security_group_ids",
+				Description: "The security group IDs of the proxy.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "vpc_subnet_ids",
+				Description: "The subnet IDs of the proxy.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags attached to the proxy.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getRDSDBProxyTags,
+				Transform:   transform.FromField("TagList"),
+			},
+
+			// Standard columns
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getRDSDBProxyTags,
+				Transform:   transform.From(getRDSDBProxyTurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBProxyName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DBProxyArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRDSDBProxies(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	plugin.Logger(ctx).Trace("listRDSDBProxies", "AWS_REGION", region)
+
+	// Create Session
+	svc, err := RDSService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// List call
+	err = svc.DescribeDBProxiesPages(
+		&rds.DescribeDBProxiesInput{},
+		func(page *rds.DescribeDBProxiesOutput, isLast bool) bool {
+			for _, dbProxy := range page.DBProxies {
+				d.StreamListItem(ctx, dbProxy)
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRDSDBProxy(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getRDSDBProxy")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	name := d.KeyColumnQuals["db_proxy_name"].GetStringValue()
+
+	// Create service
+	svc, err := RDSService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &rds.DescribeDBProxiesInput{
+		DBProxyName: aws.String(name),
+	}
+
+	op, err := svc.DescribeDBProxies(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(op.DBProxies) > 0 {
+		return op.DBProxies[0], nil
+	}
+	return nil, nil
+}
+
+func getRDSDBProxyTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getRDSDBProxyTags")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	dbProxy := h.Item.(*rds.DBProxy)
+
+	// Create service
+	svc, err := RDSService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &rds.ListTagsForResourceInput{
+		ResourceName: dbProxy.DBProxyArn,
+	}
+
+	op, err := svc.ListTagsForResource(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func getRDSDBProxyTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags := d.HydrateItem.(*rds.ListTagsForResourceOutput)
+
+	if tags.TagList != nil {
+		turbotTagsMap := map[string]string{}
+		for _, i := range tags.TagList {
+			turbotTagsMap[*i.Key] = *i.Value
+		}
+		return turbotTagsMap, nil
+	}
+	return nil, nil
+}
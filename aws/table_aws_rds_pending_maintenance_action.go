@@ -0,0 +1,106 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRDSPendingMaintenanceAction(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_rds_pending_maintenance_action",
+		Description: "AWS RDS Pending Maintenance Action",
+		List: &plugin.ListConfig{
+			Hydrate: listRDSPendingMaintenanceActions,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "resource_identifier",
+				Description: "The ARN of the DB instance or cluster the maintenance action applies to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "action",
+				Description: "The type of pending maintenance action, such as system-update, db-upgrade or os-upgrade.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "A description of the maintenance action.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "opt_in_status",
+				Description: "The opt-in request that has been received for the action, such as immediate or next-maintenance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "auto_applied_after_date",
+				Description: "The date of the maintenance window when the action is applied. Not set if the action is not applied automatically.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "forced_apply_date",
+				Description: "The date when the action is applied automatically, regardless of the maintenance window.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "current_apply_date",
+				Description: "The effective date when the action is applied, taking into account opt-in requests and the auto and forced apply dates.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Action"),
+			},
+		}),
+	}
+}
+
+type rdsPendingMaintenanceActionInfo struct {
+	ResourceIdentifier *string
+	*rds.PendingMaintenanceAction
+}
+
+//// LIST FUNCTION
+
+func listRDSPendingMaintenanceActions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	plugin.Logger(ctx).Trace("listRDSPendingMaintenanceActions", "AWS_REGION", region)
+
+	// Create Session
+	svc, err := RDSService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// List call
+	err = svc.DescribePendingMaintenanceActionsPages(
+		&rds.DescribePendingMaintenanceActionsInput{
+			ResourceIdentifier: getQualsStringValue(d, "resource_identifier"),
+		},
+		func(page *rds.DescribePendingMaintenanceActionsOutput, isLast bool) bool {
+			for _, resource := range page.PendingMaintenanceActions {
+				for _, action := range resource.PendingMaintenanceActionDetails {
+					d.StreamListItem(ctx, &rdsPendingMaintenanceActionInfo{resource.ResourceIdentifier, action})
+				}
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
@@ -0,0 +1,252 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRDSReservedDBInstance(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_rds_reserved_db_instance",
+		Description: "AWS RDS Reserved DB Instance",
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("reserved_db_instance_id"),
+			ShouldIgnoreError: isNotFoundError([]string{"ReservedDBInstanceNotFound"}),
+			Hydrate:           getRDSReservedDBInstance,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listRDSReservedDBInstances,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "reserved_db_instance_id",
+				Description: "The unique identifier for the reservation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ReservedDBInstanceId"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the reserved DB instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ReservedDBInstanceArn"),
+			},
+			{
+				Name:        "reserved_db_instances_offering_id",
+				Description: "The offering identifier.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ReservedDBInstancesOfferingId"),
+			},
+			{
+				Name:        "state",
+				Description: "The state of the reserved DB instance, such as payment-pending, active or retired.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "db_instance_class",
+				Description: "The DB instance class for the reserved DB instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBInstanceClass"),
+			},
+			{
+				Name:        "db_instance_count",
+				Description: "The number of reserved DB instances.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("DBInstanceCount"),
+			},
+			{
+				Name:        "multi_az",
+				Description: "Indicates if the reservation applies to Multi-AZ deployments.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("MultiAZ"),
+			},
+			{
+				Name:        "product_description",
+				Description: "The description of the reserved DB instance, such as the database engine.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "offering_type",
+				Description: "The offering type of the reserved DB instance, such as All Upfront or No Upfront.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "duration",
+				Description: "The duration of the reservation in seconds.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "start_time",
+				Description: "The time the reservation started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "currency_code",
+				Description: "The currency code for the reserved DB instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "fixed_price",
+				Description: "The fixed price charged for the reserved DB instance.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "usage_price",
+				Description: "The hourly price charged for the reserved DB instance.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "recurring_charges",
+				Description: "The recurring price charged to run the reserved DB instance.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "lease_id",
+				Description: "The unique identifier for the lease associated with the reserved DB instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags attached to the reserved DB instance.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getRDSReservedDBInstanceTags,
+				Transform:   transform.FromField("TagList"),
+			},
+
+			// Standard columns
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getRDSReservedDBInstanceTags,
+				Transform:   transform.From(getRDSReservedDBInstanceTurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ReservedDBInstanceId"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ReservedDBInstanceArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRDSReservedDBInstances(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	plugin.Logger(ctx).Trace("listRDSReservedDBInstances", "AWS_REGION", region)
+
+	// Create Session
+	svc, err := RDSService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// List call
+	err = svc.DescribeReservedDBInstancesPages(
+		&rds.DescribeReservedDBInstancesInput{},
+		func(page *rds.DescribeReservedDBInstancesOutput, isLast bool) bool {
+			for _, reservedDBInstance := range page.ReservedDBInstances {
+				d.StreamListItem(ctx, reservedDBInstance)
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRDSReservedDBInstance(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getRDSReservedDBInstance")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	reservedDBInstanceID := d.KeyColumnQuals["reserved_db_instance_id"].GetStringValue()
+
+	// Create service
+	svc, err := RDSService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &rds.DescribeReservedDBInstancesInput{
+		ReservedDBInstanceId: aws.String(reservedDBInstanceID),
+	}
+
+	op, err := svc.DescribeReservedDBInstances(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(op.ReservedDBInstances) > 0 {
+		return op.ReservedDBInstances[0], nil
+	}
+	return nil, nil
+}
+
+func getRDSReservedDBInstanceTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getRDSReservedDBInstanceTags")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	reservedDBInstance := h.Item.(*rds.ReservedDBInstance)
+
+	// Create service
+	svc, err := RDSService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &rds.ListTagsForResourceInput{
+		ResourceName: reservedDBInstance.ReservedDBInstanceArn,
+	}
+
+	op, err := svc.ListTagsForResource(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func getRDSReservedDBInstanceTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags := d.HydrateItem.(*rds.ListTagsForResourceOutput)
+
+	if tags.TagList != nil {
+		turbotTagsMap := map[string]string{}
+		for _, i := range tags.TagList {
+			turbotTagsMap[*i.Key] = *i.Value
+		}
+		return turbotTagsMap, nil
+	}
+	return nil, nil
+}
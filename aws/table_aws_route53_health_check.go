@@ -0,0 +1,277 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRoute53HealthCheck(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_route53_health_check",
+		Description: "AWS Route53 Health Check",
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("id"),
+			ShouldIgnoreError: isNotFoundError([]string{"NoSuchHealthCheck", "InvalidInput"}),
+			ItemFromKey:       healthCheckFromKey,
+			Hydrate:           getHealthCheck,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listHealthChecks,
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The identifier that Amazon Route 53 assigned to the health check when you created it.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of health check (HTTP | HTTPS | HTTP_STR_MATCH | HTTPS_STR_MATCH | TCP | CALCULATED | CLOUDWATCH_METRIC | RECOVERY_CONTROL).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HealthCheckConfig.Type"),
+			},
+			{
+				Name:        "caller_reference",
+				Description: "A unique string that you specified when you created the health check.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "health_check_version",
+				Description: "The version of the health check.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "fully_qualified_domain_name",
+				Description: "The domain name that Route 53 performs health checks on, if applicable.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HealthCheckConfig.FullyQualifiedDomainName"),
+			},
+			{
+				Name:        "ip_address",
+				Description: "The IPv4 or IPv6 IP address of the endpoint that Route 53 performs health checks on, if applicable.",
+				Type:        proto.ColumnType_IPADDR,
+				Transform:   transform.FromField("HealthCheckConfig.IPAddress"),
+			},
+			{
+				Name:        "port",
+				Description: "The port on the endpoint that Route 53 performs health checks on.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("HealthCheckConfig.Port"),
+			},
+			{
+				Name:        "resource_path",
+				Description: "The path that Route 53 requests when performing health checks, for HTTP and HTTPS health checks.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HealthCheckConfig.ResourcePath"),
+			},
+			{
+				Name:        "disabled",
+				Description: "If true, Route 53 stops performing health checks and considers the endpoint healthy.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("HealthCheckConfig.Disabled"),
+			},
+			{
+				Name:        "inverted",
+				Description: "If true, Route 53 inverts the status of the health check.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("HealthCheckConfig.Inverted"),
+			},
+			{
+				Name:        "linked_service_principal",
+				Description: "If the health check was created by another service, the service that created the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LinkedService.ServicePrincipal"),
+			},
+			{
+				Name:        "linked_service_description",
+				Description: "If the health check was created by another service, an optional description that can be provided by the other service.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LinkedService.Description"),
+			},
+			{
+				Name:        "health_check_config",
+				Description: "A complex type that contains detailed information about the health check.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "cloud_watch_alarm_configuration",
+				Description: "A complex type that contains information about the CloudWatch alarm that Route 53 is monitoring for this health check, for CLOUDWATCH_METRIC health checks.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "health_check_status",
+				Description: "The most recent status of the health check reported by each Route 53 health checker. Not available for CALCULATED and CLOUDWATCH_METRIC health checks.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getHealthCheckStatus,
+				Transform:   transform.FromField("HealthCheckObservations"),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the health check.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getHealthCheckTags,
+				Transform:   transform.FromField("ResourceTagSet.Tags"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getHealthCheckTags,
+				Transform:   transform.FromField("ResourceTagSet.Tags").Transform(route53HostedZoneTurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getRoute53HealthCheckTurbotAkas,
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+//// BUILD HYDRATE INPUT
+
+func healthCheckFromKey(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	quals := d.KeyColumnQuals
+	id := quals["id"].GetStringValue()
+	item := &route53.HealthCheck{
+		Id: &id,
+	}
+	return item, nil
+}
+
+//// LIST FUNCTION
+
+func listHealthChecks(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listHealthChecks")
+
+	// Create session
+	svc, err := Route53Service(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	err = svc.ListHealthChecksPages(
+		&route53.ListHealthChecksInput{},
+		func(page *route53.ListHealthChecksOutput, isLast bool) bool {
+			for _, healthCheck := range page.HealthChecks {
+				d.StreamListItem(ctx, healthCheck)
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getHealthCheck(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getHealthCheck")
+	healthCheck := h.Item.(*route53.HealthCheck)
+
+	// Create session
+	svc, err := Route53Service(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &route53.GetHealthCheckInput{
+		HealthCheckId: healthCheck.Id,
+	}
+
+	item, err := svc.GetHealthCheck(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return item.HealthCheck, nil
+}
+
+func getHealthCheckStatus(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getHealthCheckStatus")
+	healthCheck := h.Item.(*route53.HealthCheck)
+
+	// Calculated and metric based health checks have no health checker observations
+	checkType := types.SafeString(healthCheck.HealthCheckConfig.Type)
+	if checkType == route53.HealthCheckTypeCalculated || checkType == route53.HealthCheckTypeCloudwatchMetric {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := Route53Service(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &route53.GetHealthCheckStatusInput{
+		HealthCheckId: healthCheck.Id,
+	}
+
+	status, err := svc.GetHealthCheckStatus(params)
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == "InvalidInput" {
+				return nil, nil
+			}
+		}
+		return nil, err
+	}
+
+	return status, nil
+}
+
+func getHealthCheckTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getHealthCheckTags")
+	healthCheck := h.Item.(*route53.HealthCheck)
+
+	// Create session
+	svc, err := Route53Service(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &route53.ListTagsForResourceInput{
+		ResourceId:   healthCheck.Id,
+		ResourceType: types.String(route53.TagResourceTypeHealthcheck),
+	}
+
+	resp, err := svc.ListTagsForResource(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func getRoute53HealthCheckTurbotAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getRoute53HealthCheckTurbotAkas")
+	healthCheck := h.Item.(*route53.HealthCheck)
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Get data for turbot defined properties
+	akas := []string{"arn:" + commonColumnData.Partition + ":route53:::healthcheck/" + *healthCheck.Id}
+
+	return akas, nil
+}
@@ -0,0 +1,185 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRoute53KeySigningKey(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_route53_key_signing_key",
+		Description: "AWS Route53 Key Signing Key",
+		List: &plugin.ListConfig{
+			ParentHydrate: listHostedZones,
+			Hydrate:       listRoute53KeySigningKeys,
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "A string used to identify a key-signing key (KSK).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Key.Name"),
+			},
+			{
+				Name:        "hosted_zone_id",
+				Description: "The ID of the hosted zone that the key-signing key signs.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "A string that represents the current key-signing key (KSK) status (ACTIVE | INACTIVE | DELETING | ACTION_NEEDED | INTERNAL_FAILURE).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Key.Status"),
+			},
+			{
+				Name:        "status_message",
+				Description: "The status message provided for ACTION_NEEDED or INTERNAL_FAILURE statuses.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Key.StatusMessage"),
+			},
+			{
+				Name:        "dnssec_serve_signature",
+				Description: "The DNSSEC signing status of the hosted zone (SIGNING | NOT_SIGNING | DELETING | ACTION_NEEDED | INTERNAL_FAILURE).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DNSSECStatus.ServeSignature"),
+			},
+			{
+				Name:        "kms_arn",
+				Description: "The Amazon Resource Name (ARN) used to identify the customer managed key in AWS Key Management Service (AWS KMS).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Key.KmsArn"),
+			},
+			{
+				Name:        "flag",
+				Description: "An integer that specifies how the key is used. For key-signing key (KSK), this value is always 257.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Key.Flag"),
+			},
+			{
+				Name:        "key_tag",
+				Description: "An integer used to identify the DNSSEC record for the domain name.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Key.KeyTag"),
+			},
+			{
+				Name:        "signing_algorithm_mnemonic",
+				Description: "A string used to represent the signing algorithm.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Key.SigningAlgorithmMnemonic"),
+			},
+			{
+				Name:        "signing_algorithm_type",
+				Description: "An integer used to represent the signing algorithm.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Key.SigningAlgorithmType"),
+			},
+			{
+				Name:        "digest_algorithm_mnemonic",
+				Description: "A string used to represent the delegation signer digest algorithm.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Key.DigestAlgorithmMnemonic"),
+			},
+			{
+				Name:        "digest_algorithm_type",
+				Description: "An integer used to represent the delegation signer digest algorithm.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Key.DigestAlgorithmType"),
+			},
+			{
+				Name:        "digest_value",
+				Description: "A cryptographic digest of a DNSKEY resource record (RR).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Key.DigestValue"),
+			},
+			{
+				Name:        "public_key",
+				Description: "The public key, represented as a Base64 encoding.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Key.PublicKey"),
+			},
+			{
+				Name:        "ds_record",
+				Description: "A string that represents a delegation signer (DS) record.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Key.DSRecord"),
+			},
+			{
+				Name:        "dnskey_record",
+				Description: "A string that represents a DNSKEY record.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Key.DNSKEYRecord"),
+			},
+			{
+				Name:        "created_date",
+				Description: "The date when the key-signing key (KSK) was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Key.CreatedDate"),
+			},
+			{
+				Name:        "last_modified_date",
+				Description: "The last time that the key-signing key (KSK) was changed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Key.LastModifiedDate"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Key.Name"),
+			},
+		}),
+	}
+}
+
+// custom struct for a key-signing key and the DNSSEC status of its hosted zone
+type route53KeySigningKeyInfo struct {
+	HostedZoneId string
+	DNSSECStatus *route53.DNSSECStatus
+	Key          *route53.KeySigningKey
+}
+
+//// LIST FUNCTION
+
+func listRoute53KeySigningKeys(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listRoute53KeySigningKeys")
+	hostedZone := h.Item.(*route53.HostedZone)
+
+	// DNSSEC signing is only supported for public hosted zones
+	if hostedZone.Config != nil && types.BoolValue(hostedZone.Config.PrivateZone) {
+		return nil, nil
+	}
+	hostedZoneID := strings.Split(*hostedZone.Id, "/")[2]
+
+	// Create session
+	svc, err := Route53Service(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := svc.GetDNSSEC(&route53.GetDNSSECInput{
+		HostedZoneId: &hostedZoneID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range op.KeySigningKeys {
+		d.StreamLeafListItem(ctx, &route53KeySigningKeyInfo{
+			HostedZoneId: hostedZoneID,
+			DNSSECStatus: op.Status,
+			Key:          key,
+		})
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,570 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/turbot/go-kit/helpers"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+// Target services recognised in record values and alias targets
+const (
+	route53TargetServiceCloudFront       = "cloudfront"
+	route53TargetServiceEIP              = "eip"
+	route53TargetServiceELB              = "elb"
+	route53TargetServiceElasticBeanstalk = "elastic_beanstalk"
+	route53TargetServiceS3Website        = "s3_website"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRoute53RecordTarget(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_route53_record_target",
+		Description: "AWS Route53 Record Target",
+		List: &plugin.ListConfig{
+			ParentHydrate: listHostedZones,
+			Hydrate:       listRoute53RecordTargets,
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the record.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Record.Name"),
+			},
+			{
+				Name:        "zone_id",
+				Description: "The ID of the hosted zone that contains the record.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The record type.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Record.Type"),
+			},
+			{
+				Name:        "set_identifier",
+				Description: "Unique identifier to differentiate records with routing policies from one another.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Record.SetIdentifier"),
+			},
+			{
+				Name:        "private_zone",
+				Description: "If true, the record belongs to a private hosted zone and cannot be resolved from the internet.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "is_alias",
+				Description: "If true, the target is the alias target of the record rather than one of its values.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "target",
+				Description: "The DNS name or IP address the record points at, in lower case and without the trailing dot.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "target_service",
+				Description: "The AWS service the target belongs to (cloudfront | eip | elb | elastic_beanstalk | s3_website). Null for IPv4 addresses outside the published AWS EC2 ranges.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TargetService").NullIfZero(),
+			},
+			{
+				Name:        "target_region",
+				Description: "The region of the target resource, where it can be derived from the target.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "target_resource",
+				Description: "The name of the resource expected to back the target, such as the S3 bucket name.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "target_exists",
+				Description: "If true, a matching resource was found in this account. Null if the target does not belong to a recognised AWS service.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "takeover_risk",
+				Description: "If true, the record is in a public hosted zone and points at a resource that does not exist in this account. Null if the target does not belong to a recognised AWS service.",
+				Type:        proto.ColumnType_BOOL,
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Record.Name"),
+			},
+		}),
+	}
+}
+
+// custom struct for a single target of a record, and the result of
+// comparing it against the resources in the account
+type route53RecordTargetInfo struct {
+	ZoneId         string
+	Record         *route53.ResourceRecordSet
+	PrivateZone    bool
+	IsAlias        bool
+	Target         string
+	TargetService  string
+	TargetRegion   string
+	TargetResource string
+	TargetExists   *bool
+	TakeoverRisk   *bool
+}
+
+// Published AWS IP address ranges, used to tell Elastic IPs apart from
+// addresses hosted outside AWS
+const (
+	awsIPRangesURL      = "https://ip-ranges.amazonaws.com/ip-ranges.json"
+	awsIPRangesCacheTTL = 24 * time.Hour
+)
+
+// awsEC2IPRanges caches the parsed EC2 ranges. They are public, so they are
+// shared by all connections.
+var awsEC2IPRanges = newTTLCache(awsIPRangesCacheTTL)
+
+//// LIST FUNCTION
+
+func listRoute53RecordTargets(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listRoute53RecordTargets")
+	hostedZone := h.Item.(*route53.HostedZone)
+	hostedZoneID := strings.Split(*hostedZone.Id, "/")[2]
+	privateZone := hostedZone.Config != nil && types.BoolValue(hostedZone.Config.PrivateZone)
+
+	// Create session
+	svc, err := Route53Service(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []*route53.ResourceRecordSet
+	err = svc.ListResourceRecordSetsPages(
+		&route53.ListResourceRecordSetsInput{
+			HostedZoneId: &hostedZoneID,
+		},
+		func(page *route53.ListResourceRecordSetsOutput, isLast bool) bool {
+			records = append(records, page.ResourceRecordSets...)
+			return !isLast
+		},
+	)
+	if err != nil {
+		if a, ok := err.(awserr.Error); ok && helpers.StringSliceContains([]string{"InvalidParameter", "NoSuchHostedZone"}, a.Code()) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	ec2Ranges := getAwsEC2IPRanges(ctx, d)
+
+	for _, record := range records {
+		for _, item := range route53RecordTargets(hostedZoneID, privateZone, record, ec2Ranges) {
+			// Addresses outside AWS have nothing in the account to compare against
+			if item.TargetService != "" {
+				exists, err := route53RecordTargetExists(ctx, d, item)
+				if err != nil {
+					return nil, err
+				}
+				takeoverRisk := !exists && !privateZone
+				item.TargetExists = &exists
+				item.TakeoverRisk = &takeoverRisk
+			}
+			d.StreamLeafListItem(ctx, item)
+		}
+	}
+
+	return nil, nil
+}
+
+// route53RecordTargets returns one item per value or alias target of the
+// record that points at a recognised AWS resource, and one per IPv4 address in
+// an A record. Other values are skipped, as there is nothing in the account to
+// compare them against.
+func route53RecordTargets(zoneID string, privateZone bool, record *route53.ResourceRecordSet, ec2Ranges []*net.IPNet) []*route53RecordTargetInfo {
+	var items []*route53RecordTargetInfo
+	recordType := types.SafeString(record.Type)
+	add := func(target string, isAlias bool) {
+		target = strings.TrimSuffix(strings.ToLower(target), ".")
+		service, region, resource := classifyRoute53RecordTarget(types.SafeString(record.Name), recordType, target, ec2Ranges)
+		if service == "" && !isRoute53IPv4Target(recordType, target) {
+			return
+		}
+		items = append(items, &route53RecordTargetInfo{
+			ZoneId:         zoneID,
+			Record:         record,
+			PrivateZone:    privateZone,
+			IsAlias:        isAlias,
+			Target:         target,
+			TargetService:  service,
+			TargetRegion:   region,
+			TargetResource: resource,
+		})
+	}
+
+	if record.AliasTarget != nil && record.AliasTarget.DNSName != nil {
+		add(*record.AliasTarget.DNSName, true)
+		return items
+	}
+	if recordType != "A" && recordType != "CNAME" {
+		return items
+	}
+	for _, r := range record.ResourceRecords {
+		if r.Value != nil {
+			add(*r.Value, false)
+		}
+	}
+	return items
+}
+
+// classifyRoute53RecordTarget works out which service a target belongs to,
+// along with the region and the name of the resource that should back it.
+// IPv4 addresses are only treated as Elastic IPs when they are inside one of
+// the published EC2 ranges.
+func classifyRoute53RecordTarget(recordName string, recordType string, target string, ec2Ranges []*net.IPNet) (service string, region string, resource string) {
+	if isRoute53IPv4Target(recordType, target) {
+		ip := net.ParseIP(target)
+		for _, ipRange := range ec2Ranges {
+			if ipRange.Contains(ip) {
+				return route53TargetServiceEIP, "", target
+			}
+		}
+		return "", "", ""
+	}
+
+	labels := strings.Split(target, ".")
+	switch {
+	case strings.HasSuffix(target, ".cloudfront.net"):
+		return route53TargetServiceCloudFront, "", target
+
+	case strings.HasSuffix(target, ".elasticbeanstalk.com"):
+		// <cname>.<region>.elasticbeanstalk.com
+		if len(labels) < 4 {
+			return "", "", ""
+		}
+		return route53TargetServiceElasticBeanstalk, labels[len(labels)-3], target
+
+	case !strings.HasSuffix(target, ".amazonaws.com") && !strings.HasSuffix(target, ".amazonaws.com.cn"):
+		return "", "", ""
+	}
+
+	for i, label := range labels {
+		// Application and classic load balancers use <name>.<region>.elb.amazonaws.com,
+		// network load balancers use <name>.elb.<region>.amazonaws.com
+		if label == "elb" && i > 0 && i+1 < len(labels) {
+			if labels[i+1] == "amazonaws" {
+				region = labels[i-1]
+			} else {
+				region = labels[i+1]
+			}
+			return route53TargetServiceELB, region, strings.TrimPrefix(target, "dualstack.")
+		}
+
+		// Website endpoints use s3-website-<region> or s3-website.<region>. Alias
+		// records point at the regional endpoint, and the bucket must carry the
+		// record name; CNAME records carry the bucket name as a prefix.
+		if strings.HasPrefix(label, "s3-website") {
+			region = strings.TrimPrefix(strings.TrimPrefix(label, "s3-website"), "-")
+			if region == "" && i+1 < len(labels) {
+				region = labels[i+1]
+			}
+			if i == 0 {
+				resource = strings.TrimSuffix(strings.ToLower(recordName), ".")
+			} else {
+				resource = strings.Join(labels[:i], ".")
+			}
+			return route53TargetServiceS3Website, region, resource
+		}
+	}
+
+	return "", "", ""
+}
+
+func isRoute53IPv4Target(recordType string, target string) bool {
+	if recordType != "A" {
+		return false
+	}
+	ip := net.ParseIP(target)
+	return ip != nil && ip.To4() != nil
+}
+
+//// HYDRATE FUNCTIONS
+
+// getAwsEC2IPRanges returns the IPv4 ranges published for the EC2 service
+// getAwsEC2IPRanges returns the IPv4 ranges published for the EC2 service, or
+// nil if they cannot be downloaded, for example when the plugin has no
+// internet access. IPv4 targets are then left unclassified rather than failing
+// the query. A failed download is only tried once per query.
+func getAwsEC2IPRanges(ctx context.Context, d *plugin.QueryData) []*net.IPNet {
+	cacheKey := "awsEC2IPRanges"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.([]*net.IPNet)
+	}
+
+	var ranges []*net.IPNet
+	value, err := awsEC2IPRanges.GetOrCreate(awsIPRangesURL, func() (interface{}, error) {
+		return loadAwsEC2IPRanges(ctx)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Warn("getAwsEC2IPRanges", "url", awsIPRangesURL, "error", err)
+	} else {
+		ranges = value.([]*net.IPNet)
+	}
+	d.ConnectionManager.Cache.Set(cacheKey, ranges)
+
+	return ranges
+}
+
+func loadAwsEC2IPRanges(ctx context.Context) ([]*net.IPNet, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, awsIPRangesURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get AWS IP ranges from %s: %s", awsIPRangesURL, resp.Status)
+	}
+
+	var published struct {
+		Prefixes []struct {
+			IPPrefix string `json:"ip_prefix"`
+			Service  string `json:"service"`
+		} `json:"prefixes"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&published); err != nil {
+		return nil, err
+	}
+
+	var ranges []*net.IPNet
+	for _, prefix := range published.Prefixes {
+		if prefix.Service != "EC2" {
+			continue
+		}
+		_, ipRange, err := net.ParseCIDR(prefix.IPPrefix)
+		if err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, ipRange)
+	}
+
+	return ranges, nil
+}
+
+// route53RecordTargetExists looks the target up in the inventory of its service.
+// Each inventory is loaded once per query and shared by all records.
+func route53RecordTargetExists(ctx context.Context, d *plugin.QueryData, item *route53RecordTargetInfo) (bool, error) {
+	var inventory map[string]bool
+	var err error
+
+	switch item.TargetService {
+	case route53TargetServiceCloudFront:
+		inventory, err = getRoute53TargetInventory(ctx, d, "route53TargetInventory-cloudfront", listCloudFrontDomainNames)
+	case route53TargetServiceEIP:
+		inventory, err = getRoute53TargetInventory(ctx, d, "route53TargetInventory-eip", listPublicIPAddresses)
+	case route53TargetServiceELB:
+		inventory, err = getRoute53TargetInventory(ctx, d, "route53TargetInventory-elb-"+item.TargetRegion, func(ctx context.Context, d *plugin.QueryData) (map[string]bool, error) {
+			return listLoadBalancerDNSNames(ctx, d, item.TargetRegion)
+		})
+	case route53TargetServiceElasticBeanstalk:
+		inventory, err = getRoute53TargetInventory(ctx, d, "route53TargetInventory-elasticbeanstalk-"+item.TargetRegion, func(ctx context.Context, d *plugin.QueryData) (map[string]bool, error) {
+			return listElasticBeanstalkCNAMEs(ctx, d, item.TargetRegion)
+		})
+	case route53TargetServiceS3Website:
+		inventory, err = getRoute53TargetInventory(ctx, d, "route53TargetInventory-s3", listS3BucketNames)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return inventory[item.TargetResource], nil
+}
+
+func getRoute53TargetInventory(ctx context.Context, d *plugin.QueryData, cacheKey string, load func(context.Context, *plugin.QueryData) (map[string]bool, error)) (map[string]bool, error) {
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(map[string]bool), nil
+	}
+
+	inventory, err := load(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	d.ConnectionManager.Cache.Set(cacheKey, inventory)
+
+	return inventory, nil
+}
+
+func listCloudFrontDomainNames(ctx context.Context, d *plugin.QueryData) (map[string]bool, error) {
+	svc, err := CloudFrontService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[string]bool{}
+	err = svc.ListDistributionsPages(
+		&cloudfront.ListDistributionsInput{},
+		func(page *cloudfront.ListDistributionsOutput, isLast bool) bool {
+			if page.DistributionList != nil {
+				for _, distribution := range page.DistributionList.Items {
+					names[strings.ToLower(types.SafeString(distribution.DomainName))] = true
+				}
+			}
+			return !isLast
+		},
+	)
+
+	return names, err
+}
+
+// listElasticIPAddresses returns the Elastic IPs allocated in every region of the connection
+// listPublicIPAddresses returns the Elastic IPs allocated in the account, and
+// the public IPs auto-assigned to its instances, which stay in use while the
+// instance runs
+func listPublicIPAddresses(ctx context.Context, d *plugin.QueryData) (map[string]bool, error) {
+	addresses := map[string]bool{}
+	for _, matrix := range BuildRegionList(ctx, d.Connection) {
+		svc, err := Ec2Service(ctx, d, matrix[matrixKeyRegion].(string))
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := svc.DescribeAddresses(&ec2.DescribeAddressesInput{})
+		if err != nil {
+			return nil, err
+		}
+		for _, address := range resp.Addresses {
+			if address.PublicIp != nil {
+				addresses[*address.PublicIp] = true
+			}
+		}
+
+		err = svc.DescribeInstancesPages(
+			&ec2.DescribeInstancesInput{},
+			func(page *ec2.DescribeInstancesOutput, isLast bool) bool {
+				for _, reservation := range page.Reservations {
+					for _, instance := range reservation.Instances {
+						if instance.PublicIpAddress != nil {
+							addresses[*instance.PublicIpAddress] = true
+						}
+					}
+				}
+				return !isLast
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return addresses, nil
+}
+
+// listLoadBalancerDNSNames returns the DNS names of both current generation and classic load balancers
+func listLoadBalancerDNSNames(ctx context.Context, d *plugin.QueryData, region string) (map[string]bool, error) {
+	names := map[string]bool{}
+
+	svcV2, err := ELBv2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+	err = svcV2.DescribeLoadBalancersPages(
+		&elbv2.DescribeLoadBalancersInput{},
+		func(page *elbv2.DescribeLoadBalancersOutput, isLast bool) bool {
+			for _, lb := range page.LoadBalancers {
+				names[strings.ToLower(types.SafeString(lb.DNSName))] = true
+			}
+			return !isLast
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	svc, err := ELBService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+	err = svc.DescribeLoadBalancersPages(
+		&elb.DescribeLoadBalancersInput{},
+		func(page *elb.DescribeLoadBalancersOutput, isLast bool) bool {
+			for _, lb := range page.LoadBalancerDescriptions {
+				names[strings.ToLower(types.SafeString(lb.DNSName))] = true
+			}
+			return !isLast
+		},
+	)
+
+	return names, err
+}
+
+func listElasticBeanstalkCNAMEs(ctx context.Context, d *plugin.QueryData, region string) (map[string]bool, error) {
+	svc, err := ElasticBeanstalkService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[string]bool{}
+	input := &elasticbeanstalk.DescribeEnvironmentsInput{
+		IncludeDeleted: aws.Bool(false),
+	}
+	for {
+		resp, err := svc.DescribeEnvironments(input)
+		if err != nil {
+			return nil, err
+		}
+		for _, environment := range resp.Environments {
+			names[strings.ToLower(types.SafeString(environment.CNAME))] = true
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		input.NextToken = resp.NextToken
+	}
+
+	return names, nil
+}
+
+func listS3BucketNames(ctx context.Context, d *plugin.QueryData) (map[string]bool, error) {
+	svc, err := S3Service(ctx, d, GetDefaultAwsRegion(d))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := svc.ListBuckets(&s3.ListBucketsInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[string]bool{}
+	for _, bucket := range resp.Buckets {
+		names[types.SafeString(bucket.Name)] = true
+	}
+
+	return names, nil
+}
@@ -0,0 +1,287 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRoute53ResolverEndpoint(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_route53_resolver_endpoint",
+		Description: "AWS Route53 Resolver Endpoint",
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("id"),
+			ShouldIgnoreError: isNotFoundError([]string{"ResourceNotFoundException", "InvalidParameterException"}),
+			ItemFromKey:       resolverEndpointFromKey,
+			Hydrate:           getRoute53ResolverEndpoint,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listRoute53ResolverEndpoints,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name that you assigned to the Resolver endpoint when you submitted a CreateResolverEndpoint request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the Resolver endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The ARN (Amazon Resource Name) for the Resolver endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "direction",
+				Description: "Indicates whether the Resolver endpoint allows inbound or outbound DNS queries (INBOUND | OUTBOUND).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "A code that specifies the current status of the Resolver endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_message",
+				Description: "A detailed description of the status of the Resolver endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "host_vpc_id",
+				Description: "The ID of the VPC that you want to create the Resolver endpoint in.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HostVPCId"),
+			},
+			{
+				Name:        "ip_address_count",
+				Description: "The number of IP addresses that the Resolver endpoint can use for DNS queries.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The date and time that the endpoint was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "modification_time",
+				Description: "The date and time that the endpoint was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "creator_request_id",
+				Description: "A unique string that identifies the request that created the Resolver endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "security_group_ids",
+				Description: "The ID of one or more security groups that control access to this VPC.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "ip_addresses",
+				Description: "The IP addresses, subnets and network interfaces that the Resolver endpoint uses for DNS queries.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listRoute53ResolverEndpointIPAddresses,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the Resolver endpoint.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getRoute53ResolverEndpointTags,
+				Transform:   transform.FromValue(),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getRoute53ResolverEndpointTags,
+				Transform:   transform.FromValue().Transform(route53ResolverTurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// BUILD HYDRATE INPUT
+
+func resolverEndpointFromKey(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	quals := d.KeyColumnQuals
+	id := quals["id"].GetStringValue()
+	item := &route53resolver.ResolverEndpoint{
+		Id: &id,
+	}
+	return item, nil
+}
+
+//// LIST FUNCTION
+
+func listRoute53ResolverEndpoints(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	plugin.Logger(ctx).Trace("listRoute53ResolverEndpoints", "AWS_REGION", region)
+
+	// Create session
+	svc, err := Route53ResolverService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// List call
+	err = svc.ListResolverEndpointsPages(
+		&route53resolver.ListResolverEndpointsInput{},
+		func(page *route53resolver.ListResolverEndpointsOutput, isLast bool) bool {
+			for _, endpoint := range page.ResolverEndpoints {
+				d.StreamListItem(ctx, endpoint)
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRoute53ResolverEndpoint(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getRoute53ResolverEndpoint")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	endpoint := h.Item.(*route53resolver.ResolverEndpoint)
+
+	// Create session
+	svc, err := Route53ResolverService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &route53resolver.GetResolverEndpointInput{
+		ResolverEndpointId: endpoint.Id,
+	}
+
+	op, err := svc.GetResolverEndpoint(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return op.ResolverEndpoint, nil
+}
+
+func listRoute53ResolverEndpointIPAddresses(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listRoute53ResolverEndpointIPAddresses")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	endpoint := h.Item.(*route53resolver.ResolverEndpoint)
+
+	// Create session
+	svc, err := Route53ResolverService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	var ipAddresses []*route53resolver.IpAddressResponse
+	err = svc.ListResolverEndpointIpAddressesPages(
+		&route53resolver.ListResolverEndpointIpAddressesInput{
+			ResolverEndpointId: endpoint.Id,
+		},
+		func(page *route53resolver.ListResolverEndpointIpAddressesOutput, isLast bool) bool {
+			ipAddresses = append(ipAddresses, page.IpAddresses...)
+			return !isLast
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return ipAddresses, nil
+}
+
+func getRoute53ResolverEndpointTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getRoute53ResolverEndpointTags")
+	endpoint := h.Item.(*route53resolver.ResolverEndpoint)
+	return getRoute53ResolverResourceTags(ctx, d, endpoint.Arn)
+}
+
+// getRoute53ResolverResourceTags returns the tags for any Route 53 Resolver resource
+func getRoute53ResolverResourceTags(ctx context.Context, d *plugin.QueryData, arn *string) ([]*route53resolver.Tag, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+
+	// Create session
+	svc, err := Route53ResolverService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	var tags []*route53resolver.Tag
+	err = svc.ListTagsForResourcePages(
+		&route53resolver.ListTagsForResourceInput{
+			ResourceArn: arn,
+		},
+		func(page *route53resolver.ListTagsForResourceOutput, isLast bool) bool {
+			tags = append(tags, page.Tags...)
+			return !isLast
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func route53ResolverTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags, ok := d.Value.([]*route53resolver.Tag)
+
+	// Mapping the resource tags inside turbotTags
+	var turbotTagsMap map[string]string
+	if ok && tags != nil {
+		turbotTagsMap = map[string]string{}
+		for _, i := range tags {
+			turbotTagsMap[*i.Key] = *i.Value
+		}
+	}
+
+	return turbotTagsMap, nil
+}
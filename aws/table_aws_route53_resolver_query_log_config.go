@@ -0,0 +1,238 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRoute53ResolverQueryLogConfig(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_route53_resolver_query_log_config",
+		Description: "AWS Route53 Resolver Query Log Config",
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("id"),
+			ShouldIgnoreError: isNotFoundError([]string{"ResourceNotFoundException", "InvalidParameterException"}),
+			ItemFromKey:       resolverQueryLogConfigFromKey,
+			Hydrate:           getRoute53ResolverQueryLogConfig,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listRoute53ResolverQueryLogConfigs,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the query logging configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID for the query logging configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The ARN for the query logging configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the specified query logging configuration (CREATING | CREATED | DELETING | FAILED).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "destination_arn",
+				Description: "The ARN of the resource that you want Resolver to send query logs to: an S3 bucket, a CloudWatch Logs log group or a Kinesis Data Firehose delivery stream.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "association_count",
+				Description: "The number of VPCs that are associated with the query logging configuration.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "owner_id",
+				Description: "The AWS account ID for the account that created the query logging configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "share_status",
+				Description: "An indication of whether the query logging configuration is shared with other AWS accounts, or was shared with the current account by another AWS account.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The date and time that the query logging configuration was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "creator_request_id",
+				Description: "A unique string that identifies the request that created the query logging configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "associations",
+				Description: "The VPCs that are associated with the query logging configuration.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listRoute53ResolverQueryLogConfigAssociations,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the query logging configuration.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getRoute53ResolverQueryLogConfigTags,
+				Transform:   transform.FromValue(),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getRoute53ResolverQueryLogConfigTags,
+				Transform:   transform.FromValue().Transform(route53ResolverTurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// BUILD HYDRATE INPUT
+
+func resolverQueryLogConfigFromKey(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	quals := d.KeyColumnQuals
+	id := quals["id"].GetStringValue()
+	item := &route53resolver.ResolverQueryLogConfig{
+		Id: &id,
+	}
+	return item, nil
+}
+
+//// LIST FUNCTION
+
+func listRoute53ResolverQueryLogConfigs(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	plugin.Logger(ctx).Trace("listRoute53ResolverQueryLogConfigs", "AWS_REGION", region)
+
+	// Create session
+	svc, err := Route53ResolverService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// List call
+	err = svc.ListResolverQueryLogConfigsPages(
+		&route53resolver.ListResolverQueryLogConfigsInput{},
+		func(page *route53resolver.ListResolverQueryLogConfigsOutput, isLast bool) bool {
+			for _, config := range page.ResolverQueryLogConfigs {
+				d.StreamListItem(ctx, config)
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRoute53ResolverQueryLogConfig(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getRoute53ResolverQueryLogConfig")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	config := h.Item.(*route53resolver.ResolverQueryLogConfig)
+
+	// Create session
+	svc, err := Route53ResolverService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &route53resolver.GetResolverQueryLogConfigInput{
+		ResolverQueryLogConfigId: config.Id,
+	}
+
+	op, err := svc.GetResolverQueryLogConfig(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return op.ResolverQueryLogConfig, nil
+}
+
+func listRoute53ResolverQueryLogConfigAssociations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listRoute53ResolverQueryLogConfigAssociations")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	config := h.Item.(*route53resolver.ResolverQueryLogConfig)
+
+	// Create session
+	svc, err := Route53ResolverService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	var associations []*route53resolver.ResolverQueryLogConfigAssociation
+	err = svc.ListResolverQueryLogConfigAssociationsPages(
+		&route53resolver.ListResolverQueryLogConfigAssociationsInput{
+			Filters: []*route53resolver.Filter{
+				{
+					Name:   aws.String("ResolverQueryLogConfigId"),
+					Values: []*string{config.Id},
+				},
+			},
+		},
+		func(page *route53resolver.ListResolverQueryLogConfigAssociationsOutput, isLast bool) bool {
+			associations = append(associations, page.ResolverQueryLogConfigAssociations...)
+			return !isLast
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return associations, nil
+}
+
+func getRoute53ResolverQueryLogConfigTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getRoute53ResolverQueryLogConfigTags")
+	config := h.Item.(*route53resolver.ResolverQueryLogConfig)
+
+	// Configurations shared with this account by another account cannot be tagged here
+	if config.ShareStatus != nil && *config.ShareStatus == route53resolver.ShareStatusSharedWithMe {
+		return nil, nil
+	}
+	return getRoute53ResolverResourceTags(ctx, d, config.Arn)
+}
@@ -0,0 +1,258 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRoute53ResolverRule(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_route53_resolver_rule",
+		Description: "AWS Route53 Resolver Rule",
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("id"),
+			ShouldIgnoreError: isNotFoundError([]string{"ResourceNotFoundException", "InvalidParameterException"}),
+			ItemFromKey:       resolverRuleFromKey,
+			Hydrate:           getRoute53ResolverRule,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listRoute53ResolverRules,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name for the Resolver rule, which you specified when you created the Resolver rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID that Resolver assigned to the Resolver rule when you created it.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The ARN (Amazon Resource Name) for the Resolver rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "domain_name",
+				Description: "DNS queries for this domain name are forwarded to the IP addresses that are specified in target_ips.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "rule_type",
+				Description: "The type of the rule (FORWARD | SYSTEM | RECURSIVE).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "A code that specifies the current status of the Resolver rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_message",
+				Description: "A detailed description of the status of a Resolver rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resolver_endpoint_id",
+				Description: "The ID of the outbound Resolver endpoint that the rule is associated with.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "owner_id",
+				Description: "When a rule is shared with another AWS account, the account ID of the account that the rule is shared with.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "share_status",
+				Description: "Whether the rule is shared and, if so, whether the current account is sharing the rule with another account, or another account is sharing the rule with the current account.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The date and time that the Resolver rule was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "modification_time",
+				Description: "The date and time that the Resolver rule was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "creator_request_id",
+				Description: "A unique string that identifies the request that created the Resolver rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "target_ips",
+				Description: "An array that contains the IP addresses and ports that an outbound endpoint forwards DNS queries to.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "resolver_rule_associations",
+				Description: "The associations between the Resolver rule and VPCs.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listRoute53ResolverRuleAssociations,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the Resolver rule.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getRoute53ResolverRuleTags,
+				Transform:   transform.FromValue(),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getRoute53ResolverRuleTags,
+				Transform:   transform.FromValue().Transform(route53ResolverTurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// BUILD HYDRATE INPUT
+
+func resolverRuleFromKey(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	quals := d.KeyColumnQuals
+	id := quals["id"].GetStringValue()
+	item := &route53resolver.ResolverRule{
+		Id: &id,
+	}
+	return item, nil
+}
+
+//// LIST FUNCTION
+
+func listRoute53ResolverRules(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	plugin.Logger(ctx).Trace("listRoute53ResolverRules", "AWS_REGION", region)
+
+	// Create session
+	svc, err := Route53ResolverService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// List call
+	err = svc.ListResolverRulesPages(
+		&route53resolver.ListResolverRulesInput{},
+		func(page *route53resolver.ListResolverRulesOutput, isLast bool) bool {
+			for _, rule := range page.ResolverRules {
+				d.StreamListItem(ctx, rule)
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRoute53ResolverRule(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getRoute53ResolverRule")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	rule := h.Item.(*route53resolver.ResolverRule)
+
+	// Create session
+	svc, err := Route53ResolverService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &route53resolver.GetResolverRuleInput{
+		ResolverRuleId: rule.Id,
+	}
+
+	op, err := svc.GetResolverRule(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return op.ResolverRule, nil
+}
+
+func listRoute53ResolverRuleAssociations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listRoute53ResolverRuleAssociations")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	rule := h.Item.(*route53resolver.ResolverRule)
+
+	// Create session
+	svc, err := Route53ResolverService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	var associations []*route53resolver.ResolverRuleAssociation
+	err = svc.ListResolverRuleAssociationsPages(
+		&route53resolver.ListResolverRuleAssociationsInput{
+			Filters: []*route53resolver.Filter{
+				{
+					Name:   aws.String("ResolverRuleId"),
+					Values: []*string{rule.Id},
+				},
+			},
+		},
+		func(page *route53resolver.ListResolverRuleAssociationsOutput, isLast bool) bool {
+			associations = append(associations, page.ResolverRuleAssociations...)
+			return !isLast
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return associations, nil
+}
+
+func getRoute53ResolverRuleTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getRoute53ResolverRuleTags")
+	rule := h.Item.(*route53resolver.ResolverRule)
+
+	// Rules shared with this account by another account cannot be tagged here
+	if rule.ShareStatus != nil && *rule.ShareStatus == route53resolver.ShareStatusSharedWithMe {
+		return nil, nil
+	}
+	return getRoute53ResolverResourceTags(ctx, d, rule.Arn)
+}
@@ -0,0 +1,149 @@
+package aws
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRoute53TrafficPolicy(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_route53_traffic_policy",
+		Description: "AWS Route53 Traffic Policy",
+		List: &plugin.ListConfig{
+			ParentHydrate: listTrafficPolicies,
+			Hydrate:       listTrafficPolicyVersions,
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name that you specified when you created the traffic policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID that Amazon Route 53 assigned to a traffic policy when you created it.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "version",
+				Description: "The version number that Amazon Route 53 assigns to a traffic policy.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "type",
+				Description: "The DNS type of the resource record sets that Amazon Route 53 creates when you use a traffic policy to create a traffic policy instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "comment",
+				Description: "The comment that you specify in the CreateTrafficPolicy request, if any.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "document",
+				Description: "The definition of a traffic policy in JSON format.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Document").Transform(transform.UnmarshalYAML),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getRoute53TrafficPolicyTurbotAkas,
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listTrafficPolicies(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listTrafficPolicies")
+
+	// Create session
+	svc, err := Route53Service(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// ListTrafficPolicies has no paginator in the SDK
+	params := &route53.ListTrafficPoliciesInput{}
+	for {
+		resp, err := svc.ListTrafficPolicies(params)
+		if err != nil {
+			return nil, err
+		}
+		for _, policy := range resp.TrafficPolicySummaries {
+			d.StreamListItem(ctx, policy)
+		}
+		if resp.IsTruncated == nil || !*resp.IsTruncated {
+			break
+		}
+		params.TrafficPolicyIdMarker = resp.TrafficPolicyIdMarker
+	}
+
+	return nil, nil
+}
+
+func listTrafficPolicyVersions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listTrafficPolicyVersions")
+	policy := h.Item.(*route53.TrafficPolicySummary)
+
+	// Create session
+	svc, err := Route53Service(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &route53.ListTrafficPolicyVersionsInput{
+		Id: policy.Id,
+	}
+	for {
+		resp, err := svc.ListTrafficPolicyVersions(params)
+		if err != nil {
+			return nil, err
+		}
+		for _, version := range resp.TrafficPolicies {
+			d.StreamLeafListItem(ctx, version)
+		}
+		if resp.IsTruncated == nil || !*resp.IsTruncated {
+			break
+		}
+		params.TrafficPolicyVersionMarker = resp.TrafficPolicyVersionMarker
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRoute53TrafficPolicyTurbotAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getRoute53TrafficPolicyTurbotAkas")
+	policy := h.Item.(*route53.TrafficPolicy)
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Get data for turbot defined properties
+	akas := []string{"arn:" + commonColumnData.Partition + ":route53::" + commonColumnData.AccountId + ":trafficpolicy/" + *policy.Id + "/" + strconv.FormatInt(*policy.Version, 10)}
+
+	return akas, nil
+}
@@ -0,0 +1,316 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsS3AccessPoint(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_s3_access_point",
+		Description: "AWS S3 Access Point",
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("name"),
+			ShouldIgnoreError: isNotFoundError([]string{"NoSuchAccessPoint", "InvalidRequest"}),
+			Hydrate:           getS3AccessPoint,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listS3AccessPoints,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the access point.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "access_point_arn",
+				Description: "The Amazon Resource Name (ARN) of the access point.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "alias",
+				Description: "The alias of the access point, which can be used in place of a bucket name.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "bucket_name",
+				Description: "The name of the bucket associated with the access point.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Bucket"),
+			},
+			{
+				Name:        "network_origin",
+				Description: "Whether the access point allows access from the public internet (Internet) or only from a VPC (VPC).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vpc_id",
+				Description: "The ID of the VPC the access point is restricted to, if any.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VpcConfiguration.VpcId"),
+			},
+			{
+				Name:        "creation_date",
+				Description: "The date and time the access point was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getS3AccessPointDetails,
+			},
+			{
+				Name:        "block_public_acls",
+				Description: "Specifies whether Amazon S3 should block public access control lists (ACLs) for requests made through the access point.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getS3AccessPointDetails,
+				Transform:   transform.FromField("PublicAccessBlockConfiguration.BlockPublicAcls"),
+			},
+			{
+				Name:        "block_public_policy",
+				Description: "Specifies whether Amazon S3 should block public access point policies.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getS3AccessPointDetails,
+				Transform:   transform.FromField("PublicAccessBlockConfiguration.BlockPublicPolicy"),
+			},
+			{
+				Name:        "ignore_public_acls",
+				Description: "Specifies whether Amazon S3 should ignore public ACLs for requests made through the access point.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getS3AccessPointDetails,
+				Transform:   transform.FromField("PublicAccessBlockConfiguration.IgnorePublicAcls"),
+			},
+			{
+				Name:        "restrict_public_buckets",
+				Description: "Specifies whether Amazon S3 should restrict access through the access point to AWS service principals and authorized users if it has a public policy.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getS3AccessPointDetails,
+				Transform:   transform.FromField("PublicAccessBlockConfiguration.RestrictPublicBuckets"),
+			},
+			{
+				Name:        "endpoints",
+				Description: "The VPC endpoint for the access point.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3AccessPointDetails,
+			},
+			{
+				Name:        "access_point_policy_is_public",
+				Description: "Indicates whether the access point policy allows public access.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getS3AccessPointPolicyStatus,
+				Transform:   transform.FromField("PolicyStatus.IsPublic"),
+			},
+			{
+				Name:        "policy",
+				Description: "The resource policy of the access point.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3AccessPointPolicy,
+				Transform:   transform.FromField("Policy").Transform(transform.UnmarshalYAML),
+			},
+			{
+				Name:        "policy_std",
+				Description: "Contains the policy in a canonical form for easier searching.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3AccessPointPolicy,
+				Transform:   transform.FromField("Policy").Transform(policyToCanonical),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AccessPointArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listS3AccessPoints(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	plugin.Logger(ctx).Trace("listS3AccessPoints", "AWS_REGION", region)
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Create session
+	svc, err := S3ControlService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// List call
+	err = svc.ListAccessPointsPages(
+		&s3control.ListAccessPointsInput{
+			AccountId: aws.String(commonColumnData.AccountId),
+		},
+		func(page *s3control.ListAccessPointsOutput, isLast bool) bool {
+			for _, accessPoint := range page.AccessPointList {
+				d.StreamListItem(ctx, accessPoint)
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getS3AccessPoint(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getS3AccessPoint")
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	op, err := getS3AccessPointByName(ctx, d, h, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3control.AccessPoint{
+		AccessPointArn:   op.AccessPointArn,
+		Alias:            op.Alias,
+		Bucket:           op.Bucket,
+		Name:             op.Name,
+		NetworkOrigin:    op.NetworkOrigin,
+		VpcConfiguration: op.VpcConfiguration,
+	}, nil
+}
+
+func getS3AccessPointDetails(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getS3AccessPointDetails")
+	accessPoint := h.Item.(*s3control.AccessPoint)
+
+	return getS3AccessPointByName(ctx, d, h, *accessPoint.Name)
+}
+
+func getS3AccessPointPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getS3AccessPointPolicy")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	accessPoint := h.Item.(*s3control.AccessPoint)
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Create session
+	svc, err := S3ControlService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &s3control.GetAccessPointPolicyInput{
+		AccountId: aws.String(commonColumnData.AccountId),
+		Name:      accessPoint.Name,
+	}
+
+	policy, err := svc.GetAccessPointPolicy(params)
+	if err != nil {
+		if a, ok := err.(awserr.Error); ok {
+			if a.Code() == "NoSuchAccessPointPolicy" {
+				return &s3control.GetAccessPointPolicyOutput{}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+func getS3AccessPointPolicyStatus(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getS3AccessPointPolicyStatus")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	accessPoint := h.Item.(*s3control.AccessPoint)
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Create session
+	svc, err := S3ControlService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &s3control.GetAccessPointPolicyStatusInput{
+		AccountId: aws.String(commonColumnData.AccountId),
+		Name:      accessPoint.Name,
+	}
+
+	status, err := svc.GetAccessPointPolicyStatus(params)
+	if err != nil {
+		if a, ok := err.(awserr.Error); ok {
+			if a.Code() == "NoSuchAccessPointPolicy" {
+				return &s3control.GetAccessPointPolicyStatusOutput{}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return status, nil
+}
+
+// getS3AccessPointByName returns the full configuration of an access point in
+// the current region
+func getS3AccessPointByName(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, name string) (*s3control.GetAccessPointOutput, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Create session
+	svc, err := S3ControlService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &s3control.GetAccessPointInput{
+		AccountId: aws.String(commonColumnData.AccountId),
+		Name:      aws.String(name),
+	}
+
+	return svc.GetAccessPoint(params)
+}
@@ -2,10 +2,13 @@ package aws
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3control"
 	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
@@ -64,6 +67,46 @@ func tableAwsS3Bucket(_ context.Context) *plugin.Table {
 				Func:    getBucketTagging,
 				Depends: []plugin.HydrateFunc{getBucketLocation},
 			},
+			{
+				Func:    getBucketEffectivePublicAccess,
+				Depends: []plugin.HydrateFunc{getBucketIsPublic, getBucketPublicAccessBlock, getBucketACL, getBucketPolicy},
+			},
+			{
+				Func:    getBucketObjectLockConfiguration,
+				Depends: []plugin.HydrateFunc{getBucketLocation},
+			},
+			{
+				Func:    getBucketCORS,
+				Depends: []plugin.HydrateFunc{getBucketLocation},
+			},
+			{
+				Func:    getBucketWebsite,
+				Depends: []plugin.HydrateFunc{getBucketLocation},
+			},
+			{
+				Func:    getBucketNotificationConfiguration,
+				Depends: []plugin.HydrateFunc{getBucketLocation},
+			},
+			{
+				Func:    getBucketOwnershipControls,
+				Depends: []plugin.HydrateFunc{getBucketLocation},
+			},
+			{
+				Func:    getBucketRequestPayment,
+				Depends: []plugin.HydrateFunc{getBucketLocation},
+			},
+			{
+				Func:    getBucketAccelerateConfiguration,
+				Depends: []plugin.HydrateFunc{getBucketLocation},
+			},
+			{
+				Func:    getBucketIntelligentTieringConfigurations,
+				Depends: []plugin.HydrateFunc{getBucketLocation},
+			},
+			{
+				Func:    getBucketInventoryConfigurations,
+				Depends: []plugin.HydrateFunc{getBucketLocation},
+			},
 		},
 		Columns: awsS3Columns([]*plugin.Column{
 			{
@@ -175,6 +218,83 @@ func tableAwsS3Bucket(_ context.Context) *plugin.Table {
 				Hydrate:     getBucketReplication,
 				Transform:   transform.FromField("ReplicationConfiguration"),
 			},
+			{
+				Name:        "effective_public_access",
+				Description: "Whether the bucket is accessible to the public after combining its ACL, its policy and the bucket and account level public access blocks: public, blocked (public grants exist but are neutralized by a public access block) or not_public.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getBucketEffectivePublicAccess,
+				Transform:   transform.FromField("Verdict"),
+			},
+			{
+				Name:        "effective_public_access_reason",
+				Description: "The ACL grants, policy statements and public access block settings that led to the effective_public_access verdict.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getBucketEffectivePublicAccess,
+				Transform:   transform.FromField("Reason"),
+			},
+			{
+				Name:        "object_lock_configuration",
+				Description: "The object lock configuration of the bucket, including its default retention.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBucketObjectLockConfiguration,
+				Transform:   transform.FromField("ObjectLockConfiguration"),
+			},
+			{
+				Name:        "cors_rules",
+				Description: "The cross-origin resource sharing (CORS) rules of the bucket.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBucketCORS,
+				Transform:   transform.FromField("CORSRules"),
+			},
+			{
+				Name:        "website_configuration",
+				Description: "The static website hosting configuration of the bucket.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBucketWebsite,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "event_notification_configuration",
+				Description: "The event notification configuration of the bucket, with the SNS topics, SQS queues and Lambda functions it notifies.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBucketNotificationConfiguration,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "object_ownership_controls",
+				Description: "The object ownership controls of the bucket.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBucketOwnershipControls,
+				Transform:   transform.FromField("OwnershipControls"),
+			},
+			{
+				Name:        "request_payer",
+				Description: "Who pays for requests and downloads from the bucket, BucketOwner or Requester.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getBucketRequestPayment,
+				Transform:   transform.FromField("Payer"),
+			},
+			{
+				Name:        "transfer_acceleration_status",
+				Description: "The transfer acceleration status of the bucket, Enabled or Suspended. Null if it has never been set.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getBucketAccelerateConfiguration,
+				Transform:   transform.FromField("Status"),
+			},
+			{
+				Name:        "intelligent_tiering_configurations",
+				Description: "The S3 Intelligent-Tiering configurations of the bucket.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBucketIntelligentTieringConfigurations,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "inventory_configurations",
+				Description: "The inventory configurations of the bucket.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBucketInventoryConfigurations,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "tags_src",
 				Description: "A list of tags assigned to bucket",
@@ -242,7 +362,21 @@ func listS3Buckets(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDa
 		return nil, err
 	}
 
+	// If the query is limited to a region, look up the bucket regions up front
+	// so buckets in other regions are skipped before any per bucket calls
+	region := getQualsStringValue(d, "region")
+	var bucketRegions map[string]string
+	if region != nil {
+		bucketRegions, err = getBucketRegions(ctx, d, bucketsResult.Buckets)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	for _, bucket := range bucketsResult.Buckets {
+		if region != nil && bucketRegions[*bucket.Name] != *region {
+			continue
+		}
 		d.StreamListItem(ctx, bucket)
 	}
 
@@ -283,33 +417,14 @@ func getS3Bucket(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 func getBucketLocation(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	plugin.Logger(ctx).Trace("getBucketLocation")
 	bucket := h.Item.(*s3.Bucket)
-	defaultRegion := GetDefaultAwsRegion(d)
-
-	// Create Session
-	svc, err := S3Service(ctx, d, defaultRegion)
-	if err != nil {
-		return nil, err
-	}
-
-	params := &s3.GetBucketLocationInput{
-		Bucket: bucket.Name,
-	}
-
-	// Specifies the Region where the bucket resides. For a list of all the Amazon
-	// S3 supported location constraints by Region, see Regions and Endpoints (https://docs.aws.amazon.com/general/latest/gr/rande.html#s3_region).
 
-	location, err := svc.GetBucketLocation(params)
+	region, err := getBucketRegion(ctx, d, *bucket.Name)
 	if err != nil {
 		return nil, err
 	}
 
-	if location != nil && location.LocationConstraint != nil {
-		return location, nil
-	}
-
-	// Buckets in Region us-east-1 have a LocationConstraint of null.
 	return &s3.GetBucketLocationOutput{
-		LocationConstraint: aws.String("us-east-1"),
+		LocationConstraint: aws.String(region),
 	}, nil
 }
 
@@ -579,6 +694,373 @@ func getBucketTagging(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 	return bucketTags, nil
 }
 
+func getBucketObjectLockConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getBucketObjectLockConfiguration")
+	bucket := h.Item.(*s3.Bucket)
+	location := h.HydrateResults["getBucketLocation"].(*s3.GetBucketLocationOutput)
+
+	// Create Session
+	svc, err := S3Service(ctx, d, *location.LocationConstraint)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &s3.GetObjectLockConfigurationInput{
+		Bucket: bucket.Name,
+	}
+
+	objectLock, err := svc.GetObjectLockConfiguration(params)
+	if err != nil {
+		if a, ok := err.(awserr.Error); ok {
+			if a.Code() == "ObjectLockConfigurationNotFoundError" {
+				return nil, nil
+			}
+		}
+		return nil, err
+	}
+
+	return objectLock, nil
+}
+
+func getBucketCORS(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getBucketCORS")
+	bucket := h.Item.(*s3.Bucket)
+	location := h.HydrateResults["getBucketLocation"].(*s3.GetBucketLocationOutput)
+
+	// Create Session
+	svc, err := S3Service(ctx, d, *location.LocationConstraint)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &s3.GetBucketCorsInput{
+		Bucket: bucket.Name,
+	}
+
+	cors, err := svc.GetBucketCors(params)
+	if err != nil {
+		if a, ok := err.(awserr.Error); ok {
+			if a.Code() == "NoSuchCORSConfiguration" {
+				return nil, nil
+			}
+		}
+		return nil, err
+	}
+
+	return cors, nil
+}
+
+func getBucketWebsite(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getBucketWebsite")
+	bucket := h.Item.(*s3.Bucket)
+	location := h.HydrateResults["getBucketLocation"].(*s3.GetBucketLocationOutput)
+
+	// Create Session
+	svc, err := S3Service(ctx, d, *location.LocationConstraint)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &s3.GetBucketWebsiteInput{
+		Bucket: bucket.Name,
+	}
+
+	website, err := svc.GetBucketWebsite(params)
+	if err != nil {
+		if a, ok := err.(awserr.Error); ok {
+			if a.Code() == "NoSuchWebsiteConfiguration" {
+				return nil, nil
+			}
+		}
+		return nil, err
+	}
+
+	return website, nil
+}
+
+func getBucketNotificationConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getBucketNotificationConfiguration")
+	bucket := h.Item.(*s3.Bucket)
+	location := h.HydrateResults["getBucketLocation"].(*s3.GetBucketLocationOutput)
+
+	// Create Session
+	svc, err := S3Service(ctx, d, *location.LocationConstraint)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &s3.GetBucketNotificationConfigurationRequest{
+		Bucket: bucket.Name,
+	}
+
+	notification, err := svc.GetBucketNotificationConfiguration(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return notification, nil
+}
+
+func getBucketOwnershipControls(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getBucketOwnershipControls")
+	bucket := h.Item.(*s3.Bucket)
+	location := h.HydrateResults["getBucketLocation"].(*s3.GetBucketLocationOutput)
+
+	// Create Session
+	svc, err := S3Service(ctx, d, *location.LocationConstraint)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &s3.GetBucketOwnershipControlsInput{
+		Bucket: bucket.Name,
+	}
+
+	ownershipControls, err := svc.GetBucketOwnershipControls(params)
+	if err != nil {
+		if a, ok := err.(awserr.Error); ok {
+			if a.Code() == "OwnershipControlsNotFoundError" {
+				return nil, nil
+			}
+		}
+		return nil, err
+	}
+
+	return ownershipControls, nil
+}
+
+func getBucketRequestPayment(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getBucketRequestPayment")
+	bucket := h.Item.(*s3.Bucket)
+	location := h.HydrateResults["getBucketLocation"].(*s3.GetBucketLocationOutput)
+
+	// Create Session
+	svc, err := S3Service(ctx, d, *location.LocationConstraint)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &s3.GetBucketRequestPaymentInput{
+		Bucket: bucket.Name,
+	}
+
+	requestPayment, err := svc.GetBucketRequestPayment(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return requestPayment, nil
+}
+
+func getBucketAccelerateConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getBucketAccelerateConfiguration")
+	bucket := h.Item.(*s3.Bucket)
+	location := h.HydrateResults["getBucketLocation"].(*s3.GetBucketLocationOutput)
+
+	// Create Session
+	svc, err := S3Service(ctx, d, *location.LocationConstraint)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &s3.GetBucketAccelerateConfigurationInput{
+		Bucket: bucket.Name,
+	}
+
+	accelerate, err := svc.GetBucketAccelerateConfiguration(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return accelerate, nil
+}
+
+func getBucketIntelligentTieringConfigurations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getBucketIntelligentTieringConfigurations")
+	bucket := h.Item.(*s3.Bucket)
+	location := h.HydrateResults["getBucketLocation"].(*s3.GetBucketLocationOutput)
+
+	// Create Session
+	svc, err := S3Service(ctx, d, *location.LocationConstraint)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &s3.ListBucketIntelligentTieringConfigurationsInput{
+		Bucket: bucket.Name,
+	}
+
+	// There is no pages function for this call, so follow the continuation token
+	var configurations []*s3.IntelligentTieringConfiguration
+	for {
+		op, err := svc.ListBucketIntelligentTieringConfigurations(params)
+		if err != nil {
+			return nil, err
+		}
+		configurations = append(configurations, op.IntelligentTieringConfigurationList...)
+		if !aws.BoolValue(op.IsTruncated) {
+			break
+		}
+		params.ContinuationToken = op.NextContinuationToken
+	}
+
+	return configurations, nil
+}
+
+func getBucketInventoryConfigurations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getBucketInventoryConfigurations")
+	bucket := h.Item.(*s3.Bucket)
+	location := h.HydrateResults["getBucketLocation"].(*s3.GetBucketLocationOutput)
+
+	// Create Session
+	svc, err := S3Service(ctx, d, *location.LocationConstraint)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &s3.ListBucketInventoryConfigurationsInput{
+		Bucket: bucket.Name,
+	}
+
+	// There is no pages function for this call, so follow the continuation token
+	var configurations []*s3.InventoryConfiguration
+	for {
+		op, err := svc.ListBucketInventoryConfigurations(params)
+		if err != nil {
+			return nil, err
+		}
+		configurations = append(configurations, op.InventoryConfigurationList...)
+		if !aws.BoolValue(op.IsTruncated) {
+			break
+		}
+		params.ContinuationToken = op.NextContinuationToken
+	}
+
+	return configurations, nil
+}
+
+// s3BucketPublicAccess is the effective public access verdict for a bucket
+type s3BucketPublicAccess struct {
+	Verdict string
+	Reason  string
+}
+
+// s3PublicGroupGrantees are the ACL grantee groups that make a grant public
+var s3PublicGroupGrantees = map[string]string{
+	"http://acs.amazonaws.com/groups/global/AllUsers":           "AllUsers",
+	"http://acs.amazonaws.com/groups/global/AuthenticatedUsers": "AuthenticatedUsers",
+}
+
+func getBucketEffectivePublicAccess(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getBucketEffectivePublicAccess")
+	policyStatus := h.HydrateResults["getBucketIsPublic"].(*s3.GetBucketPolicyStatusOutput)
+	bucketAccessBlock := h.HydrateResults["getBucketPublicAccessBlock"].(*s3.PublicAccessBlockConfiguration)
+	acl := h.HydrateResults["getBucketACL"].(*s3.GetBucketAclOutput)
+	policy := h.HydrateResults["getBucketPolicy"].(*s3.GetBucketPolicyOutput)
+
+	accountAccessBlock, err := getS3AccountPublicAccessBlock(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only IgnorePublicAcls and RestrictPublicBuckets affect existing grants,
+	// the Block settings only stop new public grants being added
+	ignoredBy := s3AccessBlockLayers(accountAccessBlock.IgnorePublicAcls, bucketAccessBlock.IgnorePublicAcls)
+	restrictedBy := s3AccessBlockLayers(accountAccessBlock.RestrictPublicBuckets, bucketAccessBlock.RestrictPublicBuckets)
+
+	var public, blocked []string
+
+	for _, grant := range acl.Grants {
+		if grant.Grantee == nil || grant.Grantee.URI == nil {
+			continue
+		}
+		group, ok := s3PublicGroupGrantees[*grant.Grantee.URI]
+		if !ok {
+			continue
+		}
+		reason := "ACL grants " + aws.StringValue(grant.Permission) + " to " + group
+		if ignoredBy != "" {
+			blocked = append(blocked, reason+", ignored by the "+ignoredBy+" IgnorePublicAcls setting")
+		} else {
+			public = append(public, reason)
+		}
+	}
+
+	var policyReasons []string
+	if policy.Policy != nil {
+		canonical, err := canonicalPolicy(*policy.Policy)
+		if err != nil {
+			return nil, err
+		}
+		for i, statement := range policyPublicStatements(canonical.(Policy)) {
+			name := statement.Sid
+			if name == "" {
+				name = fmt.Sprintf("%d", i+1)
+			}
+			actions := strings.Join(statement.Action, ", ")
+			if actions == "" {
+				actions = "actions other than " + strings.Join(statement.NotAction, ", ")
+			}
+			policyReasons = append(policyReasons, "policy statement "+name+" allows "+actions+" to anyone")
+		}
+	}
+	// S3 may find public access the statement check does not, such as
+	// through a condition value that matches anything
+	if len(policyReasons) == 0 && policyStatus.PolicyStatus != nil && aws.BoolValue(policyStatus.PolicyStatus.IsPublic) {
+		policyReasons = append(policyReasons, "bucket policy is public")
+	}
+	for _, reason := range policyReasons {
+		if restrictedBy != "" {
+			blocked = append(blocked, reason+", restricted by the "+restrictedBy+" RestrictPublicBuckets setting")
+		} else {
+			public = append(public, reason)
+		}
+	}
+
+	switch {
+	case len(public) > 0:
+		return &s3BucketPublicAccess{Verdict: "public", Reason: strings.Join(public, "; ")}, nil
+	case len(blocked) > 0:
+		return &s3BucketPublicAccess{Verdict: "blocked", Reason: strings.Join(blocked, "; ")}, nil
+	}
+	return &s3BucketPublicAccess{Verdict: "not_public", Reason: "no ACL grant or policy statement allows public access"}, nil
+}
+
+// getS3AccountPublicAccessBlock returns the account level public access block,
+// which is cached as it applies to every bucket
+func getS3AccountPublicAccessBlock(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (*s3control.PublicAccessBlockConfiguration, error) {
+	cacheKey := "s3AccountPublicAccessBlock"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(*s3control.PublicAccessBlockConfiguration), nil
+	}
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	accessBlock, err := getAccountBucketPublicAccessBlock(ctx, d, &plugin.HydrateData{Item: commonData})
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(cacheKey, accessBlock)
+	return accessBlock.(*s3control.PublicAccessBlockConfiguration), nil
+}
+
+// s3AccessBlockLayers names the public access block layers with a setting on
+func s3AccessBlockLayers(account *bool, bucket *bool) string {
+	switch {
+	case aws.BoolValue(account) && aws.BoolValue(bucket):
+		return "account and bucket"
+	case aws.BoolValue(account):
+		return "account"
+	case aws.BoolValue(bucket):
+		return "bucket"
+	}
+	return ""
+}
+
 //// TRANSFORM FUNCTIONS
 
 func s3NameToAkas(ctx context.Context, d *transform.TransformData) (interface{}, error) {
@@ -589,7 +1071,7 @@ func s3NameToAkas(ctx context.Context, d *transform.TransformData) (interface{},
 
 func s3TagsToTurbotTags(ctx context.Context, d *transform.TransformData) (interface{}, error) {
 	plugin.Logger(ctx).Trace("s3TagsToTurbotTags")
-	tags := d.Value.([]*s3.Tag)
+	tags, _ := d.Value.([]*s3.Tag)
 
 	// Mapping the resource tags inside turbotTags
 	var turbotTagsMap map[string]string
@@ -0,0 +1,269 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+// Multi-Region Access Point requests are always routed to us-west-2
+const s3MultiRegionAccessPointRegion = "us-west-2"
+
+//// TABLE DEFINITION
+
+func tableAwsS3MultiRegionAccessPoint(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_s3_multi_region_access_point",
+		Description: "AWS S3 Multi-Region Access Point",
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("name"),
+			ShouldIgnoreError: isNotFoundError([]string{"NoSuchMultiRegionAccessPoint"}),
+			Hydrate:           getS3MultiRegionAccessPoint,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listS3MultiRegionAccessPoints,
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the Multi-Region Access Point.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "alias",
+				Description: "The alias of the Multi-Region Access Point.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The current status of the Multi-Region Access Point, such as READY or CREATING.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_at",
+				Description: "The date and time the Multi-Region Access Point was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "regions",
+				Description: "The buckets, and their regions, that the Multi-Region Access Point routes requests to.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "block_public_acls",
+				Description: "Specifies whether Amazon S3 should block public access control lists (ACLs) for requests made through the access point.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("PublicAccessBlock.BlockPublicAcls"),
+			},
+			{
+				Name:        "block_public_policy",
+				Description: "Specifies whether Amazon S3 should block public access point policies.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("PublicAccessBlock.BlockPublicPolicy"),
+			},
+			{
+				Name:        "ignore_public_acls",
+				Description: "Specifies whether Amazon S3 should ignore public ACLs for requests made through the access point.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("PublicAccessBlock.IgnorePublicAcls"),
+			},
+			{
+				Name:        "restrict_public_buckets",
+				Description: "Specifies whether Amazon S3 should restrict access through the access point to AWS service principals and authorized users if it has a public policy.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("PublicAccessBlock.RestrictPublicBuckets"),
+			},
+			{
+				Name:        "access_point_policy_is_public",
+				Description: "Indicates whether the established policy of the Multi-Region Access Point allows public access.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getS3MultiRegionAccessPointPolicyStatus,
+				Transform:   transform.FromField("Established.IsPublic"),
+			},
+			{
+				Name:        "policy",
+				Description: "The established resource policy of the Multi-Region Access Point.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3MultiRegionAccessPointPolicy,
+				Transform:   transform.FromField("Policy.Established.Policy").Transform(transform.UnmarshalYAML),
+			},
+			{
+				Name:        "policy_std",
+				Description: "Contains the policy in a canonical form for easier searching.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3MultiRegionAccessPointPolicy,
+				Transform:   transform.FromField("Policy.Established.Policy").Transform(policyToCanonical),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCommonColumns,
+				Transform:   transform.From(s3MultiRegionAccessPointAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listS3MultiRegionAccessPoints(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listS3MultiRegionAccessPoints")
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Multi-Region Access Points are only available in the commercial partition
+	if commonColumnData.Partition != "aws" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := S3ControlService(ctx, d, s3MultiRegionAccessPointRegion)
+	if err != nil {
+		return nil, err
+	}
+
+	// List call
+	err = svc.ListMultiRegionAccessPointsPages(
+		&s3control.ListMultiRegionAccessPointsInput{
+			AccountId: aws.String(commonColumnData.AccountId),
+		},
+		func(page *s3control.ListMultiRegionAccessPointsOutput, isLast bool) bool {
+			for _, accessPoint := range page.AccessPoints {
+				d.StreamListItem(ctx, accessPoint)
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getS3MultiRegionAccessPoint(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getS3MultiRegionAccessPoint")
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	if commonColumnData.Partition != "aws" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := S3ControlService(ctx, d, s3MultiRegionAccessPointRegion)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &s3control.GetMultiRegionAccessPointInput{
+		AccountId: aws.String(commonColumnData.AccountId),
+		Name:      aws.String(name),
+	}
+
+	op, err := svc.GetMultiRegionAccessPoint(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return op.AccessPoint, nil
+}
+
+func getS3MultiRegionAccessPointPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getS3MultiRegionAccessPointPolicy")
+	accessPoint := h.Item.(*s3control.MultiRegionAccessPointReport)
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Create session
+	svc, err := S3ControlService(ctx, d, s3MultiRegionAccessPointRegion)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &s3control.GetMultiRegionAccessPointPolicyInput{
+		AccountId: aws.String(commonColumnData.AccountId),
+		Name:      accessPoint.Name,
+	}
+
+	policy, err := svc.GetMultiRegionAccessPointPolicy(params)
+	if err != nil {
+		if a, ok := err.(awserr.Error); ok {
+			if a.Code() == "NoSuchAccessPointPolicy" {
+				return &s3control.GetMultiRegionAccessPointPolicyOutput{}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+func getS3MultiRegionAccessPointPolicyStatus(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getS3MultiRegionAccessPointPolicyStatus")
+	accessPoint := h.Item.(*s3control.MultiRegionAccessPointReport)
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Create session
+	svc, err := S3ControlService(ctx, d, s3MultiRegionAccessPointRegion)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &s3control.GetMultiRegionAccessPointPolicyStatusInput{
+		AccountId: aws.String(commonColumnData.AccountId),
+		Name:      accessPoint.Name,
+	}
+
+	status, err := svc.GetMultiRegionAccessPointPolicyStatus(params)
+	if err != nil {
+		if a, ok := err.(awserr.Error); ok {
+			if a.Code() == "NoSuchAccessPointPolicy" {
+				return &s3control.GetMultiRegionAccessPointPolicyStatusOutput{}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return status, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func s3MultiRegionAccessPointAkas(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("s3MultiRegionAccessPointAkas")
+	accessPoint := d.HydrateItem.(*s3control.MultiRegionAccessPointReport)
+	commonColumnData := d.HydrateResults["getCommonColumns"].(*awsCommonColumnData)
+	return []string{"arn:" + commonColumnData.Partition + ":s3::" + commonColumnData.AccountId + ":accesspoint/" + *accessPoint.Alias}, nil
+}
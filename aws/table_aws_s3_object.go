@@ -0,0 +1,404 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+// maxS3ObjectRows is the most objects listed for a single bucket, so that a
+// query without a narrow prefix can not list an entire large bucket
+const maxS3ObjectRows = 10000
+
+//// TABLE DEFINITION
+
+func tableAwsS3Object(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_s3_object",
+		Description: "AWS S3 Object",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.SingleColumn("bucket"),
+			Hydrate:    listS3Objects,
+		},
+		Columns: awsS3Columns([]*plugin.Column{
+			{
+				Name:        "bucket",
+				Description: "The name of the bucket containing the object.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "key",
+				Description: "The name of the object. For a common prefix, the prefix.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_common_prefix",
+				Description: "True if the row is a common prefix of keys rolled up by the delimiter, rather than an object.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "prefix",
+				Description: "Limits the results to keys that begin with this prefix. Only set when given in the query.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "delimiter",
+				Description: "The character used to group keys into common prefixes. Only set when given in the query.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "start_after",
+				Description: "Limits the results to keys after this key. Only set when given in the query.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "etag",
+				Description: "The entity tag of the object, a hash of its content.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ETag"),
+			},
+			{
+				Name:        "size",
+				Description: "The size of the object in bytes.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "last_modified",
+				Description: "The date and time the object was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "storage_class",
+				Description: "The class of storage used to store the object.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "owner",
+				Description: "The owner of the object.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "content_type",
+				Description: "A standard MIME type describing the format of the object data.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getS3ObjectHead,
+			},
+			{
+				Name:        "cache_control",
+				Description: "The caching behavior of the object.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getS3ObjectHead,
+			},
+			{
+				Name:        "content_encoding",
+				Description: "The content encodings applied to the object.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getS3ObjectHead,
+			},
+			{
+				Name:        "version_id",
+				Description: "The version ID of the object.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getS3ObjectHead,
+			},
+			{
+				Name:        "server_side_encryption",
+				Description: "The server-side encryption algorithm used to store the object, such as AES256 or aws:kms.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getS3ObjectHead,
+			},
+			{
+				Name:        "sse_kms_key_id",
+				Description: "The ID of the KMS key used to encrypt the object, if any.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getS3ObjectHead,
+				Transform:   transform.FromField("SSEKMSKeyId"),
+			},
+			{
+				Name:        "bucket_key_enabled",
+				Description: "Indicates whether the object uses an S3 Bucket Key for server-side encryption with KMS.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getS3ObjectHead,
+			},
+			{
+				Name:        "replication_status",
+				Description: "The replication status of the object, if it is the source or replica of a replication rule.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getS3ObjectHead,
+			},
+			{
+				Name:        "expiration",
+				Description: "The expiry date and lifecycle rule ID of the object, if it matches an expiration rule.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getS3ObjectHead,
+			},
+			{
+				Name:        "metadata",
+				Description: "The user-defined metadata of the object.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3ObjectHead,
+			},
+			{
+				Name:        "object_lock_legal_hold_status",
+				Description: "Whether a legal hold is in effect for the object.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getS3ObjectHead,
+			},
+			{
+				Name:        "object_lock_mode",
+				Description: "The object lock retention mode of the object, GOVERNANCE or COMPLIANCE.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getS3ObjectRetention,
+				Transform:   transform.FromField("Mode"),
+			},
+			{
+				Name:        "object_lock_retain_until_date",
+				Description: "The date until which the object is locked.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getS3ObjectRetention,
+				Transform:   transform.FromField("RetainUntilDate"),
+			},
+			{
+				Name:        "acl",
+				Description: "The access control list (ACL) of the object.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3ObjectACL,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the object.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3ObjectTagging,
+				Transform:   transform.FromField("TagSet"),
+			},
+
+			// Standard columns
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3ObjectTagging,
+				Transform:   transform.FromField("TagSet").Transform(s3TagsToTurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Key"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCommonColumns,
+				Transform:   transform.From(s3ObjectAkas),
+			},
+			{
+				Name:        "region",
+				Description: "The AWS Region in which the resource is located",
+				Type:        proto.ColumnType_STRING,
+			},
+		}),
+	}
+}
+
+// s3ObjectInfo is an object or common prefix of a bucket, along with the
+// bucket region and the list filters used to find it so they match the quals
+type s3ObjectInfo struct {
+	Bucket         string
+	Region         string
+	Prefix         *string
+	Delimiter      *string
+	StartAfter     *string
+	IsCommonPrefix bool
+	*s3.Object
+}
+
+//// LIST FUNCTION
+
+func listS3Objects(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	bucket := d.KeyColumnQuals["bucket"].GetStringValue()
+	plugin.Logger(ctx).Trace("listS3Objects", "bucket", bucket)
+
+	// Objects must be listed in the region of the bucket
+	location, err := getBucketLocation(ctx, d, &plugin.HydrateData{Item: &s3.Bucket{Name: aws.String(bucket)}})
+	if err != nil {
+		return nil, err
+	}
+	region := *location.(*s3.GetBucketLocationOutput).LocationConstraint
+
+	// Create Session
+	svc, err := S3Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &s3.ListObjectsV2Input{
+		Bucket:     aws.String(bucket),
+		Prefix:     getQualsStringValue(d, "prefix"),
+		Delimiter:  getQualsStringValue(d, "delimiter"),
+		StartAfter: getQualsStringValue(d, "start_after"),
+		FetchOwner: aws.Bool(true),
+	}
+
+	rows := 0
+	stream := func(object *s3.Object, isCommonPrefix bool) {
+		d.StreamListItem(ctx, &s3ObjectInfo{
+			Bucket:         bucket,
+			Region:         region,
+			Prefix:         input.Prefix,
+			Delimiter:      input.Delimiter,
+			StartAfter:     input.StartAfter,
+			IsCommonPrefix: isCommonPrefix,
+			Object:         object,
+		})
+		rows++
+	}
+
+	// List call
+	err = svc.ListObjectsV2Pages(
+		input,
+		func(page *s3.ListObjectsV2Output, isLast bool) bool {
+			for _, object := range page.Contents {
+				stream(object, false)
+			}
+			for _, prefix := range page.CommonPrefixes {
+				stream(&s3.Object{Key: prefix.Prefix}, true)
+			}
+			if rows >= maxS3ObjectRows {
+				plugin.Logger(ctx).Warn("listS3Objects", "bucket", bucket, "row limit reached", maxS3ObjectRows)
+				return false
+			}
+			return !isLast && ctx.Err() == nil
+		},
+	)
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getS3ObjectHead(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getS3ObjectHead")
+	object := h.Item.(*s3ObjectInfo)
+	if object.IsCommonPrefix {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := S3Service(ctx, d, object.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &s3.HeadObjectInput{
+		Bucket: aws.String(object.Bucket),
+		Key:    object.Key,
+	}
+
+	head, err := svc.HeadObject(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return head, nil
+}
+
+func getS3ObjectRetention(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getS3ObjectRetention")
+	object := h.Item.(*s3ObjectInfo)
+	if object.IsCommonPrefix {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := S3Service(ctx, d, object.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &s3.GetObjectRetentionInput{
+		Bucket: aws.String(object.Bucket),
+		Key:    object.Key,
+	}
+
+	retention, err := svc.GetObjectRetention(params)
+	if err != nil {
+		// Returned for objects without retention, or in buckets without object lock
+		if a, ok := err.(awserr.Error); ok {
+			if a.Code() == "NoSuchObjectLockConfiguration" || a.Code() == "InvalidRequest" {
+				return nil, nil
+			}
+		}
+		return nil, err
+	}
+
+	return retention.Retention, nil
+}
+
+func getS3ObjectACL(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getS3ObjectACL")
+	object := h.Item.(*s3ObjectInfo)
+	if object.IsCommonPrefix {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := S3Service(ctx, d, object.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &s3.GetObjectAclInput{
+		Bucket: aws.String(object.Bucket),
+		Key:    object.Key,
+	}
+
+	acl, err := svc.GetObjectAcl(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return acl, nil
+}
+
+func getS3ObjectTagging(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getS3ObjectTagging")
+	object := h.Item.(*s3ObjectInfo)
+	if object.IsCommonPrefix {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := S3Service(ctx, d, object.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &s3.GetObjectTaggingInput{
+		Bucket: aws.String(object.Bucket),
+		Key:    object.Key,
+	}
+
+	tags, err := svc.GetObjectTagging(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func s3ObjectAkas(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("s3ObjectAkas")
+	object := d.HydrateItem.(*s3ObjectInfo)
+	commonColumnData := d.HydrateResults["getCommonColumns"].(*awsCommonColumnData)
+	return []string{"arn:" + commonColumnData.Partition + ":s3:::" + object.Bucket + "/" + *object.Key}, nil
+}
@@ -0,0 +1,369 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsS3ObjectLambdaAccessPoint(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_s3_object_lambda_access_point",
+		Description: "AWS S3 Object Lambda Access Point",
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("name"),
+			ShouldIgnoreError: isNotFoundError([]string{"NoSuchAccessPoint", "InvalidRequest"}),
+			Hydrate:           getS3ObjectLambdaAccessPoint,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listS3ObjectLambdaAccessPoints,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the Object Lambda access point.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "object_lambda_access_point_arn",
+				Description: "The Amazon Resource Name (ARN) of the Object Lambda access point.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_date",
+				Description: "The date and time the Object Lambda access point was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getS3ObjectLambdaAccessPointDetails,
+			},
+			{
+				Name:        "supporting_access_point",
+				Description: "The ARN of the standard access point that the Object Lambda access point reads objects through.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getS3ObjectLambdaAccessPointConfiguration,
+				Transform:   transform.FromField("Configuration.SupportingAccessPoint"),
+			},
+			{
+				Name:        "cloud_watch_metrics_enabled",
+				Description: "Whether CloudWatch metrics are enabled for the Object Lambda access point.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getS3ObjectLambdaAccessPointConfiguration,
+				Transform:   transform.FromField("Configuration.CloudWatchMetricsEnabled"),
+			},
+			{
+				Name:        "allowed_features",
+				Description: "The features the Object Lambda access point supports, such as range and part number requests.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3ObjectLambdaAccessPointConfiguration,
+				Transform:   transform.FromField("Configuration.AllowedFeatures"),
+			},
+			{
+				Name:        "transformation_configurations",
+				Description: "The Lambda functions that transform objects, and the S3 actions they are invoked for.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3ObjectLambdaAccessPointConfiguration,
+				Transform:   transform.FromField("Configuration.TransformationConfigurations"),
+			},
+			{
+				Name:        "block_public_acls",
+				Description: "Specifies whether Amazon S3 should block public access control lists (ACLs) for requests made through the access point.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getS3ObjectLambdaAccessPointDetails,
+				Transform:   transform.FromField("PublicAccessBlockConfiguration.BlockPublicAcls"),
+			},
+			{
+				Name:        "block_public_policy",
+				Description: "Specifies whether Amazon S3 should block public access point policies.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getS3ObjectLambdaAccessPointDetails,
+				Transform:   transform.FromField("PublicAccessBlockConfiguration.BlockPublicPolicy"),
+			},
+			{
+				Name:        "ignore_public_acls",
+				Description: "Specifies whether Amazon S3 should ignore public ACLs for requests made through the access point.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getS3ObjectLambdaAccessPointDetails,
+				Transform:   transform.FromField("PublicAccessBlockConfiguration.IgnorePublicAcls"),
+			},
+			{
+				Name:        "restrict_public_buckets",
+				Description: "Specifies whether Amazon S3 should restrict access through the access point to AWS service principals and authorized users if it has a public policy.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getS3ObjectLambdaAccessPointDetails,
+				Transform:   transform.FromField("PublicAccessBlockConfiguration.RestrictPublicBuckets"),
+			},
+			{
+				Name:        "access_point_policy_is_public",
+				Description: "Indicates whether the Object Lambda access point policy allows public access.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getS3ObjectLambdaAccessPointPolicyStatus,
+				Transform:   transform.FromField("PolicyStatus.IsPublic"),
+			},
+			{
+				Name:        "policy",
+				Description: "The resource policy of the Object Lambda access point.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3ObjectLambdaAccessPointPolicy,
+				Transform:   transform.FromField("Policy").Transform(transform.UnmarshalYAML),
+			},
+			{
+				Name:        "policy_std",
+				Description: "Contains the policy in a canonical form for easier searching.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3ObjectLambdaAccessPointPolicy,
+				Transform:   transform.FromField("Policy").Transform(policyToCanonical),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ObjectLambdaAccessPointArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listS3ObjectLambdaAccessPoints(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	plugin.Logger(ctx).Trace("listS3ObjectLambdaAccessPoints", "AWS_REGION", region)
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Create session
+	svc, err := S3ControlService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// List call
+	err = svc.ListAccessPointsForObjectLambdaPages(
+		&s3control.ListAccessPointsForObjectLambdaInput{
+			AccountId: aws.String(commonColumnData.AccountId),
+		},
+		func(page *s3control.ListAccessPointsForObjectLambdaOutput, isLast bool) bool {
+			for _, accessPoint := range page.ObjectLambdaAccessPointList {
+				d.StreamListItem(ctx, accessPoint)
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getS3ObjectLambdaAccessPoint(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getS3ObjectLambdaAccessPoint")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Create session
+	svc, err := S3ControlService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &s3control.GetAccessPointForObjectLambdaInput{
+		AccountId: aws.String(commonColumnData.AccountId),
+		Name:      aws.String(name),
+	}
+
+	op, err := svc.GetAccessPointForObjectLambda(params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The get call does not return the ARN
+	return &s3control.ObjectLambdaAccessPoint{
+		Name:                       op.Name,
+		ObjectLambdaAccessPointArn: aws.String("arn:" + commonColumnData.Partition + ":s3-object-lambda:" + region + ":" + commonColumnData.AccountId + ":accesspoint/" + *op.Name),
+	}, nil
+}
+
+func getS3ObjectLambdaAccessPointDetails(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getS3ObjectLambdaAccessPointDetails")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	accessPoint := h.Item.(*s3control.ObjectLambdaAccessPoint)
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Create session
+	svc, err := S3ControlService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &s3control.GetAccessPointForObjectLambdaInput{
+		AccountId: aws.String(commonColumnData.AccountId),
+		Name:      accessPoint.Name,
+	}
+
+	op, err := svc.GetAccessPointForObjectLambda(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getS3ObjectLambdaAccessPointConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getS3ObjectLambdaAccessPointConfiguration")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	accessPoint := h.Item.(*s3control.ObjectLambdaAccessPoint)
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Create session
+	svc, err := S3ControlService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &s3control.GetAccessPointConfigurationForObjectLambdaInput{
+		AccountId: aws.String(commonColumnData.AccountId),
+		Name:      accessPoint.Name,
+	}
+
+	op, err := svc.GetAccessPointConfigurationForObjectLambda(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getS3ObjectLambdaAccessPointPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getS3ObjectLambdaAccessPointPolicy")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	accessPoint := h.Item.(*s3control.ObjectLambdaAccessPoint)
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Create session
+	svc, err := S3ControlService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &s3control.GetAccessPointPolicyForObjectLambdaInput{
+		AccountId: aws.String(commonColumnData.AccountId),
+		Name:      accessPoint.Name,
+	}
+
+	policy, err := svc.GetAccessPointPolicyForObjectLambda(params)
+	if err != nil {
+		if a, ok := err.(awserr.Error); ok {
+			if a.Code() == "NoSuchAccessPointPolicy" {
+				return &s3control.GetAccessPointPolicyForObjectLambdaOutput{}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+func getS3ObjectLambdaAccessPointPolicyStatus(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getS3ObjectLambdaAccessPointPolicyStatus")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	accessPoint := h.Item.(*s3control.ObjectLambdaAccessPoint)
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Create session
+	svc, err := S3ControlService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &s3control.GetAccessPointPolicyStatusForObjectLambdaInput{
+		AccountId: aws.String(commonColumnData.AccountId),
+		Name:      accessPoint.Name,
+	}
+
+	status, err := svc.GetAccessPointPolicyStatusForObjectLambda(params)
+	if err != nil {
+		if a, ok := err.(awserr.Error); ok {
+			if a.Code() == "NoSuchAccessPointPolicy" {
+				return &s3control.GetAccessPointPolicyStatusForObjectLambdaOutput{}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return status, nil
+}
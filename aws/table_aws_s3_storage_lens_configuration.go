@@ -0,0 +1,272 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsS3StorageLensConfiguration(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_s3_storage_lens_configuration",
+		Description: "AWS S3 Storage Lens Configuration",
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("id"),
+			ShouldIgnoreError: isNotFoundError([]string{"NoSuchConfiguration", "InvalidRequest"}),
+			Hydrate:           getS3StorageLensConfigurationEntry,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listS3StorageLensConfigurations,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the Storage Lens configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the Storage Lens configuration.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("StorageLensArn"),
+			},
+			{
+				Name:        "is_enabled",
+				Description: "Whether the Storage Lens configuration is enabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "account_level",
+				Description: "The account level and bucket level metrics and activity the configuration collects.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3StorageLensConfiguration,
+				Transform:   transform.FromField("StorageLensConfiguration.AccountLevel"),
+			},
+			{
+				Name:        "aws_org",
+				Description: "The AWS organization the configuration covers, if it is an organization-level configuration.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3StorageLensConfiguration,
+				Transform:   transform.FromField("StorageLensConfiguration.AwsOrg"),
+			},
+			{
+				Name:        "data_export",
+				Description: "Where the configuration exports its metrics to, if anywhere.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3StorageLensConfiguration,
+				Transform:   transform.FromField("StorageLensConfiguration.DataExport"),
+			},
+			{
+				Name:        "include",
+				Description: "The buckets and regions the configuration is limited to.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3StorageLensConfiguration,
+				Transform:   transform.FromField("StorageLensConfiguration.Include"),
+			},
+			{
+				Name:        "exclude",
+				Description: "The buckets and regions the configuration excludes.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3StorageLensConfiguration,
+				Transform:   transform.FromField("StorageLensConfiguration.Exclude"),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the Storage Lens configuration.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3StorageLensConfigurationTagging,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getS3StorageLensConfigurationTagging,
+				Transform:   transform.FromField("Tags").Transform(s3StorageLensTagsToTurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("StorageLensArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listS3StorageLensConfigurations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	plugin.Logger(ctx).Trace("listS3StorageLensConfigurations", "AWS_REGION", region)
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Create session
+	svc, err := S3ControlService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// List call
+	err = svc.ListStorageLensConfigurationsPages(
+		&s3control.ListStorageLensConfigurationsInput{
+			AccountId: aws.String(commonColumnData.AccountId),
+		},
+		func(page *s3control.ListStorageLensConfigurationsOutput, isLast bool) bool {
+			for _, configuration := range page.StorageLensConfigurationList {
+				// Only list each configuration once, in its home region
+				if aws.StringValue(configuration.HomeRegion) != region {
+					continue
+				}
+				d.StreamListItem(ctx, configuration)
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getS3StorageLensConfigurationEntry(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getS3StorageLensConfigurationEntry")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	id := d.KeyColumnQuals["id"].GetStringValue()
+
+	op, err := getS3StorageLensConfigurationByID(ctx, d, h, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only return the configuration from its home region
+	configurationArn, err := arn.Parse(aws.StringValue(op.StorageLensConfiguration.StorageLensArn))
+	if err != nil || configurationArn.Region != region {
+		return nil, nil
+	}
+
+	return &s3control.ListStorageLensConfigurationEntry{
+		HomeRegion:     aws.String(region),
+		Id:             op.StorageLensConfiguration.Id,
+		IsEnabled:      op.StorageLensConfiguration.IsEnabled,
+		StorageLensArn: op.StorageLensConfiguration.StorageLensArn,
+	}, nil
+}
+
+func getS3StorageLensConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getS3StorageLensConfiguration")
+	configuration := h.Item.(*s3control.ListStorageLensConfigurationEntry)
+
+	return getS3StorageLensConfigurationByID(ctx, d, h, *configuration.Id)
+}
+
+func getS3StorageLensConfigurationTagging(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getS3StorageLensConfigurationTagging")
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	configuration := h.Item.(*s3control.ListStorageLensConfigurationEntry)
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Create session
+	svc, err := S3ControlService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &s3control.GetStorageLensConfigurationTaggingInput{
+		AccountId: aws.String(commonColumnData.AccountId),
+		ConfigId:  configuration.Id,
+	}
+
+	tags, err := svc.GetStorageLensConfigurationTagging(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// getS3StorageLensConfigurationByID returns the full Storage Lens
+// configuration with the ID, from its home region
+func getS3StorageLensConfigurationByID(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, id string) (*s3control.GetStorageLensConfigurationOutput, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Create session
+	svc, err := S3ControlService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &s3control.GetStorageLensConfigurationInput{
+		AccountId: aws.String(commonColumnData.AccountId),
+		ConfigId:  aws.String(id),
+	}
+
+	return svc.GetStorageLensConfiguration(params)
+}
+
+//// TRANSFORM FUNCTIONS
+
+func s3StorageLensTagsToTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags, ok := d.Value.([]*s3control.StorageLensTag)
+	if !ok || len(tags) == 0 {
+		return nil, nil
+	}
+
+	turbotTagsMap := map[string]string{}
+	for _, tag := range tags {
+		turbotTagsMap[*tag.Key] = *tag.Value
+	}
+	return turbotTagsMap, nil
+}
@@ -0,0 +1,293 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsVpcNetworkACLRule(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_vpc_network_acl_rule",
+		Description: "AWS VPC Network ACL Rule",
+		List: &plugin.ListConfig{
+			ParentHydrate: listVpcNetworkACLs,
+			Hydrate:       listVpcNetworkACLRules,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "network_acl_id",
+				Description: "The ID of the network ACL to which the rule belongs",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NetworkAcl.NetworkAclId"),
+			},
+			{
+				Name:        "rule_number",
+				Description: "The rule number for the entry. ACL entries are processed in ascending order by rule number",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Entry.RuleNumber"),
+			},
+			{
+				Name:        "type",
+				Description: "Type of the rule ( ingress | egress)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(networkACLRuleType),
+			},
+			{
+				Name:        "rule_action",
+				Description: "Indicates whether to allow or deny the traffic that matches the rule (allow | deny)",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entry.RuleAction"),
+			},
+			{
+				Name:        "protocol",
+				Description: "The protocol number. A value of -1 means all protocols",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entry.Protocol"),
+			},
+			{
+				Name:        "from_port",
+				Description: "The first port in the range, for the TCP and UDP protocols",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Entry.PortRange.From"),
+			},
+			{
+				Name:        "to_port",
+				Description: "The last port in the range, for the TCP and UDP protocols",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Entry.PortRange.To"),
+			},
+			{
+				Name:        "port_range",
+				Description: "The port range covered by the rule, in the form from-to (for example 22-22 or 0-65535). Rules without a port range, for all protocols, tcp or udp, are reported as 0-65535. Null for other protocols",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(networkACLRulePortRange),
+			},
+			{
+				Name:        "icmp_type",
+				Description: "The ICMP type. A value of -1 means all types",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Entry.IcmpTypeCode.Type"),
+			},
+			{
+				Name:        "icmp_code",
+				Description: "The ICMP code. A value of -1 means all codes for the specified ICMP type",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Entry.IcmpTypeCode.Code"),
+			},
+			{
+				Name:        "cidr_block",
+				Description: "The IPv4 network range to allow or deny, in CIDR notation",
+				Type:        proto.ColumnType_CIDR,
+				Transform:   transform.FromField("Entry.CidrBlock"),
+			},
+			{
+				Name:        "ipv6_cidr_block",
+				Description: "The IPv6 network range to allow or deny, in CIDR notation",
+				Type:        proto.ColumnType_CIDR,
+				Transform:   transform.FromField("Entry.Ipv6CidrBlock"),
+			},
+			{
+				Name:        "effective",
+				Description: "False if all traffic matched by the rule is already matched by a single lower-numbered rule in the same direction, so that the rule can never take effect",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Effective"),
+			},
+			{
+				Name:        "shadowed_by_rule_number",
+				Description: "The number of the lower-numbered rule that shadows this rule, if the rule is not effective",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ShadowedBy"),
+			},
+			{
+				Name:        "vpc_id",
+				Description: "The ID of the VPC for the network ACL",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NetworkAcl.VpcId"),
+			},
+			{
+				Name:        "owner_id",
+				Description: "The ID of the AWS account that owns the network ACL",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NetworkAcl.OwnerId"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(networkACLRuleTitle),
+			},
+		}),
+	}
+}
+
+// custom struct for network ACL rule
+type vpcNetworkACLRuleRowData struct {
+	NetworkAcl *ec2.NetworkAcl
+	Entry      *ec2.NetworkAclEntry
+	Effective  bool
+	ShadowedBy *int64
+}
+
+//// LIST FUNCTION
+
+func listVpcNetworkACLRules(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listVpcNetworkACLRules")
+	networkACL := h.Item.(*ec2.NetworkAcl)
+
+	for _, row := range networkACLRuleRows(networkACL) {
+		d.StreamLeafListItem(ctx, row)
+	}
+
+	return nil, nil
+}
+
+// networkACLRuleRows returns one row per entry in the network ACL, in
+// evaluation order, with the effective flag worked out for each entry
+func networkACLRuleRows(networkACL *ec2.NetworkAcl) []*vpcNetworkACLRuleRowData {
+	entries := make([]*ec2.NetworkAclEntry, len(networkACL.Entries))
+	copy(entries, networkACL.Entries)
+	sort.SliceStable(entries, func(i, j int) bool {
+		return types.Int64Value(entries[i].RuleNumber) < types.Int64Value(entries[j].RuleNumber)
+	})
+
+	rows := make([]*vpcNetworkACLRuleRowData, 0, len(entries))
+	for i, entry := range entries {
+		row := &vpcNetworkACLRuleRowData{
+			NetworkAcl: networkACL,
+			Entry:      entry,
+			Effective:  true,
+		}
+		// ingress and egress entries are evaluated independently, first match wins
+		for _, earlier := range entries[:i] {
+			if types.BoolValue(earlier.Egress) != types.BoolValue(entry.Egress) {
+				continue
+			}
+			if types.Int64Value(earlier.RuleNumber) == types.Int64Value(entry.RuleNumber) {
+				continue
+			}
+			if networkACLEntryCovers(earlier, entry) {
+				row.Effective = false
+				row.ShadowedBy = earlier.RuleNumber
+				break
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+// networkACLEntryCovers returns true if every packet matched by entry is
+// also matched by the covering entry
+func networkACLEntryCovers(covering *ec2.NetworkAclEntry, entry *ec2.NetworkAclEntry) bool {
+	// address ranges
+	if covering.CidrBlock != nil || entry.CidrBlock != nil {
+		if !cidrCovers(types.SafeString(covering.CidrBlock), types.SafeString(entry.CidrBlock)) {
+			return false
+		}
+	} else if !cidrCovers(types.SafeString(covering.Ipv6CidrBlock), types.SafeString(entry.Ipv6CidrBlock)) {
+		return false
+	}
+
+	// protocols
+	coveringProtocol := types.SafeString(covering.Protocol)
+	protocol := types.SafeString(entry.Protocol)
+	if coveringProtocol == "-1" {
+		return true
+	}
+	if coveringProtocol != protocol {
+		return false
+	}
+
+	switch protocol {
+	case "6", "17":
+		coveringFrom, coveringTo := networkACLEntryPorts(covering)
+		from, to := networkACLEntryPorts(entry)
+		return coveringFrom <= from && coveringTo >= to
+	case "1", "58":
+		if covering.IcmpTypeCode == nil || types.Int64Value(covering.IcmpTypeCode.Type) == -1 {
+			return true
+		}
+		if entry.IcmpTypeCode == nil || types.Int64Value(entry.IcmpTypeCode.Type) != types.Int64Value(covering.IcmpTypeCode.Type) {
+			return false
+		}
+		return types.Int64Value(covering.IcmpTypeCode.Code) == -1 || types.Int64Value(covering.IcmpTypeCode.Code) == types.Int64Value(entry.IcmpTypeCode.Code)
+	}
+
+	return true
+}
+
+// networkACLEntryPorts returns the port range of a TCP or UDP entry,
+// treating a missing range as all ports
+func networkACLEntryPorts(entry *ec2.NetworkAclEntry) (int64, int64) {
+	if entry.PortRange == nil || entry.PortRange.From == nil || entry.PortRange.To == nil {
+		return 0, 65535
+	}
+	return *entry.PortRange.From, *entry.PortRange.To
+}
+
+// cidrCovers returns true if the covering CIDR block contains the whole of the given CIDR block
+func cidrCovers(covering string, cidr string) bool {
+	_, coveringNet, err := net.ParseCIDR(covering)
+	if err != nil {
+		return false
+	}
+	_, cidrNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return false
+	}
+
+	coveringOnes, coveringBits := coveringNet.Mask.Size()
+	ones, bits := cidrNet.Mask.Size()
+	return coveringBits == bits && coveringOnes <= ones && coveringNet.Contains(cidrNet.IP)
+}
+
+//// TRANSFORM FUNCTIONS
+
+func networkACLRuleType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	row := d.HydrateItem.(*vpcNetworkACLRuleRowData)
+	if types.BoolValue(row.Entry.Egress) {
+		return "egress", nil
+	}
+	return "ingress", nil
+}
+
+func networkACLRulePortRange(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	entry := d.HydrateItem.(*vpcNetworkACLRuleRowData).Entry
+
+	switch types.SafeString(entry.Protocol) {
+	case "-1", "6", "17":
+		from, to := networkACLEntryPorts(entry)
+		return fmt.Sprintf("%d-%d", from, to), nil
+	}
+	return nil, nil
+}
+
+func networkACLRuleTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	row := d.HydrateItem.(*vpcNetworkACLRuleRowData)
+	ruleType := "ingress"
+	if types.BoolValue(row.Entry.Egress) {
+		ruleType = "egress"
+	}
+	title := *row.NetworkAcl.NetworkAclId + "_" + ruleType + "_" + strconv.FormatInt(*row.Entry.RuleNumber, 10)
+
+	// the default rules share a rule number across IPv4 and IPv6
+	if row.Entry.Ipv6CidrBlock != nil {
+		title = title + "_ipv6"
+	}
+	return title, nil
+}
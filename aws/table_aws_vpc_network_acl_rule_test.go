@@ -0,0 +1,84 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestNetworkACLRuleRowsEffective(t *testing.T) {
+	tcp := func(ruleNumber int64, action string, cidr string, from int64, to int64) *ec2.NetworkAclEntry {
+		return &ec2.NetworkAclEntry{
+			RuleNumber: aws.Int64(ruleNumber),
+			RuleAction: aws.String(action),
+			Protocol:   aws.String("6"),
+			Egress:     aws.Bool(false),
+			CidrBlock:  aws.String(cidr),
+			PortRange:  &ec2.PortRange{From: aws.Int64(from), To: aws.Int64(to)},
+		}
+	}
+	networkACL := &ec2.NetworkAcl{
+		NetworkAclId: aws.String("acl-0123456789abcdef0"),
+		Entries: []*ec2.NetworkAclEntry{
+			// deliberately out of order, entries are evaluated by rule number
+			tcp(300, "allow", "10.0.1.0/24", 22, 22),
+			tcp(100, "deny", "10.0.0.0/16", 0, 65535),
+			tcp(200, "allow", "0.0.0.0/0", 443, 443),
+			tcp(400, "allow", "192.168.0.0/24", 22, 22),
+			{
+				RuleNumber: aws.Int64(32767),
+				RuleAction: aws.String("deny"),
+				Protocol:   aws.String("-1"),
+				Egress:     aws.Bool(false),
+				CidrBlock:  aws.String("0.0.0.0/0"),
+			},
+			{
+				RuleNumber:    aws.Int64(32767),
+				RuleAction:    aws.String("deny"),
+				Protocol:      aws.String("-1"),
+				Egress:        aws.Bool(false),
+				Ipv6CidrBlock: aws.String("::/0"),
+			},
+			{
+				RuleNumber: aws.Int64(100),
+				RuleAction: aws.String("allow"),
+				Protocol:   aws.String("-1"),
+				Egress:     aws.Bool(true),
+				CidrBlock:  aws.String("0.0.0.0/0"),
+			},
+		},
+	}
+
+	expected := []struct {
+		ruleNumber int64
+		egress     bool
+		effective  bool
+		shadowedBy int64
+	}{
+		{100, false, true, 0},
+		{100, true, true, 0},
+		{200, false, true, 0},
+		{300, false, false, 100},
+		{400, false, true, 0},
+		{32767, false, true, 0},
+		{32767, false, true, 0},
+	}
+
+	rows := networkACLRuleRows(networkACL)
+	if len(rows) != len(expected) {
+		t.Fatalf("expected %d rows, got %d", len(expected), len(rows))
+	}
+	for i, row := range rows {
+		e := expected[i]
+		if *row.Entry.RuleNumber != e.ruleNumber || *row.Entry.Egress != e.egress {
+			t.Errorf("row %d: expected rule %d (egress %t), got rule %d (egress %t)", i, e.ruleNumber, e.egress, *row.Entry.RuleNumber, *row.Entry.Egress)
+		}
+		if row.Effective != e.effective {
+			t.Errorf("rule %d: expected effective %t, got %t", e.ruleNumber, e.effective, row.Effective)
+		}
+		if aws.Int64Value(row.ShadowedBy) != e.shadowedBy {
+			t.Errorf("rule %d: expected shadowed by %d, got %d", e.ruleNumber, e.shadowedBy, aws.Int64Value(row.ShadowedBy))
+		}
+	}
+}
@@ -2,9 +2,12 @@ package aws
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/turbot/go-kit/types"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/plugin"
@@ -15,13 +18,23 @@ func tableAwsVpcSecurityGroupRule(_ context.Context) *plugin.Table {
 	return &plugin.Table{
 		Name:        "aws_vpc_security_group_rule",
 		Description: "AWS VPC Security Group Rule",
-		// TODO -- get call returning a list of items
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("security_group_rule_id"),
+			ShouldIgnoreError: isNotFoundError([]string{"InvalidSecurityGroupRuleId.Malformed", "InvalidSecurityGroupRuleId.NotFound"}),
+			ItemFromKey:       securityGroupRuleFromKey,
+			Hydrate:           getSecurityGroupRule,
+		},
 		List: &plugin.ListConfig{
-			ParentHydrate: listVpcSecurityGroups,
-			Hydrate:       listSecurityGroupRules,
+			Hydrate: listSecurityGroupRules,
 		},
 		GetMatrixItem: BuildRegionList,
 		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "security_group_rule_id",
+				Description: "The ID of the security group rule",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Rule.SecurityGroupRuleId"),
+			},
 			{
 				Name:        "group_name",
 				Description: "The name of the security group to which rule belongs",
@@ -32,12 +45,19 @@ func tableAwsVpcSecurityGroupRule(_ context.Context) *plugin.Table {
 				Name:        "group_id",
 				Description: "The ID of the security group to which rule belongs",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Group.GroupId"),
+				Transform:   transform.FromField("Rule.GroupId"),
 			},
 			{
 				Name:        "type",
 				Description: "Type of the rule ( ingress | egress)",
 				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(securityGroupRuleType),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the security group rule",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Rule.Description"),
 			},
 			{
 				Name:        "vpc_id",
@@ -49,206 +69,432 @@ func tableAwsVpcSecurityGroupRule(_ context.Context) *plugin.Table {
 				Name:        "owner_id",
 				Description: "The AWS account ID of the owner of the security group to which rule belongs",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Group.OwnerId"),
+				Transform:   transform.FromField("Rule.GroupOwnerId"),
 			},
 			{
 				Name:        "ip_protocol",
 				Description: "The IP protocol name (tcp, udp, icmp, icmpv6) or number [see Protocol Numbers ](http://www.iana.org/assignments/protocol-numbers/protocol-numbers.xhtml). Use -1 to specify all protocols. When authorizing security group rules, specifying -1 or a protocol number other than tcp, udp, icmp, or icmpv6 allows traffic on all ports, regardless of any port range specified. For tcp, udp, and icmp, a port range is specified. For icmpv6, the port range is optional. If port range is omitted, traffic for all types and codes is allowed",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Permission.IpProtocol"),
+				Transform:   transform.FromField("Rule.IpProtocol"),
 			},
 			{
 				Name:        "from_port",
 				Description: "The start of port range for the TCP and UDP protocols, or an ICMP/ICMPv6 type number. A value of -1 indicates all ICMP/ICMPv6 types",
 				Type:        proto.ColumnType_INT,
-				Transform:   transform.FromField("Permission.FromPort"),
+				Transform:   transform.FromField("Rule.FromPort"),
 			},
 			{
 				Name:        "to_port",
 				Description: "The end of port range for the TCP and UDP protocols, or an ICMP/ICMPv6 code. A value of -1 indicates all ICMP/ICMPv6 codes",
 				Type:        proto.ColumnType_INT,
-				Transform:   transform.FromField("Permission.ToPort"),
+				Transform:   transform.FromField("Rule.ToPort"),
+			},
+			{
+				Name:        "port_range",
+				Description: "The port range covered by the rule, in the form from-to (for example 22-22 or 0-65535). Rules that allow all traffic, or all ports of a protocol other than icmp and icmpv6, are reported as 0-65535. Null for icmp and icmpv6 rules",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(securityGroupRulePortRange),
 			},
 			{
 				Name:        "cidr_ip",
 				Description: "The IPv4 CIDR range. It can be either a CIDR range or a source security group, not both. A single IPv4 address is denoted by /32 prefix length",
 				Type:        proto.ColumnType_CIDR,
-				Transform:   transform.FromField("IPRange.CidrIp"),
+				Transform:   transform.FromField("Rule.CidrIpv4"),
 			},
 			{
 				Name:        "cidr_ipv6",
 				Description: "The IPv6 CIDR range. It can be either a CIDR range or a source security group, not both. A single IPv6 address is denoted by /128 prefix length",
 				Type:        proto.ColumnType_CIDR,
-				Transform:   transform.FromField("Ipv6Range.CidrIpv6"),
+				Transform:   transform.FromField("Rule.CidrIpv6"),
+			},
+			{
+				Name:        "prefix_list_id",
+				Description: "The ID of the prefix list referenced by the rule, if applicable",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Rule.PrefixListId"),
 			},
 			{
 				Name:        "pair_group_id",
 				Description: "The ID of the security group that references this user ID group pair",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("UserIDGroupPair.GroupId"),
+				Transform:   transform.FromField("Rule.ReferencedGroupInfo.GroupId"),
 			},
 			{
 				Name:        "pair_group_name",
 				Description: "The name of the security group that references this user ID group pair",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("UserIDGroupPair.GroupName"),
+				Transform:   transform.From(securityGroupRulePairGroupName),
 			},
 			{
 				Name:        "pair_peering_status",
 				Description: "The status of a VPC peering connection, if applicable",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("UserIDGroupPair.PeeringStatus"),
+				Transform:   transform.FromField("Rule.ReferencedGroupInfo.PeeringStatus"),
 			},
 			{
 				Name:        "pair_user_id",
 				Description: "The ID of an AWS account. For a referenced security group in another VPC, the account ID of the referenced security group is returned in the response. If the referenced security group is deleted, this value is not returned",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("UserIDGroupPair.UserId"),
+				Transform:   transform.FromField("Rule.ReferencedGroupInfo.UserId"),
 			},
 			{
 				Name:        "pair_vpc_id",
 				Description: "The ID of the VPC for the referenced security group, if applicable",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("UserIDGroupPair.VpcId"),
+				Transform:   transform.FromField("Rule.ReferencedGroupInfo.VpcId"),
 			},
 			{
 				Name:        "pair_vpc_peering_connection_id",
 				Description: "The ID of the VPC peering connection, if applicable",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("UserIDGroupPair.VpcPeeringConnectionId"),
+				Transform:   transform.FromField("Rule.ReferencedGroupInfo.VpcPeeringConnectionId"),
+			},
+			{
+				Name:        "pair_is_peered",
+				Description: "True if the referenced security group belongs to a peered VPC",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.From(securityGroupRulePairIsPeered),
+			},
+			{
+				Name:        "pair_is_stale",
+				Description: "True if the referenced security group has been deleted, or belongs to a peered VPC whose peering connection has been deleted",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getSecurityGroupRulePairStaleStatus,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the security group rule",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Rule.Tags"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(getSecurityGroupRuleTurbotTags),
 			},
 			{
 				Name:        "title",
 				Description: resourceInterfaceDescription("title"),
 				Type:        proto.ColumnType_STRING,
-				Hydrate:     getSecurityGroupRuleTurbotData,
-				Transform:   transform.FromField("Title"),
+				Transform:   transform.FromField("Rule.SecurityGroupRuleId"),
 			},
 			{
 				Name:        "akas",
 				Description: resourceInterfaceDescription("akas"),
 				Type:        proto.ColumnType_JSON,
-				Hydrate:     getSecurityGroupRuleTurbotData,
-				Transform:   transform.FromField("Akas"),
+				Hydrate:     getSecurityGroupRuleTurbotAkas,
+				Transform:   transform.FromValue(),
 			},
 		}),
 	}
 }
 
+// custom struct for security group rule
+type vpcSecurityGroupRulesRowData struct {
+	Group *ec2.SecurityGroup
+	Rule  *ec2.SecurityGroupRule
+}
+
+//// ITEM FROM KEY
+
+func securityGroupRuleFromKey(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	quals := d.KeyColumnQuals
+	ruleID := quals["security_group_rule_id"].GetStringValue()
+	item := &vpcSecurityGroupRulesRowData{
+		Rule: &ec2.SecurityGroupRule{
+			SecurityGroupRuleId: &ruleID,
+		},
+	}
+	return item, nil
+}
+
 //// LIST FUNCTION
 
-func listSecurityGroupRules(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+func listSecurityGroupRules(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
 	plugin.Logger(ctx).Trace("listSecurityGroupRules")
-	securityGroup := h.Item.(*ec2.SecurityGroup)
 
-	if securityGroup.IpPermissions != nil {
-		for _, permission := range securityGroup.IpPermissions {
-			for _, sgRule := range rowSourceFromIPPermission(securityGroup, permission, "ingress") {
-				d.StreamLeafListItem(ctx, sgRule)
-			}
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+
+	// Create session
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// The groups and the rules are each listed once per region and joined in
+	// memory, rather than listing the rules of each group separately
+	groupsInput := &ec2.DescribeSecurityGroupsInput{}
+	rulesInput := &ec2.DescribeSecurityGroupRulesInput{}
+	if groupID := getQualsStringValue(d, "group_id"); groupID != nil {
+		groupsInput.GroupIds = []*string{groupID}
+		rulesInput.Filters = []*ec2.Filter{
+			{
+				Name:   aws.String("group-id"),
+				Values: []*string{groupID},
+			},
 		}
 	}
 
-	if securityGroup.IpPermissionsEgress != nil {
-		for _, permission := range securityGroup.IpPermissionsEgress {
-			for _, sgRule := range rowSourceFromIPPermission(securityGroup, permission, "egress") {
-				d.StreamLeafListItem(ctx, sgRule)
+	groups := map[string]*ec2.SecurityGroup{}
+	err = svc.DescribeSecurityGroupsPages(
+		groupsInput,
+		func(page *ec2.DescribeSecurityGroupsOutput, isLast bool) bool {
+			for _, group := range page.SecurityGroups {
+				groups[*group.GroupId] = group
 			}
+			return !isLast
+		},
+	)
+	if err != nil {
+		// A group_id that does not exist in this region
+		if a, ok := err.(awserr.Error); ok && (a.Code() == "InvalidGroup.NotFound" || a.Code() == "InvalidGroupId.Malformed") {
+			return nil, nil
 		}
-
+		return nil, err
 	}
 
-	return nil, nil
+	// List call
+	err = svc.DescribeSecurityGroupRulesPages(
+		rulesInput,
+		func(page *ec2.DescribeSecurityGroupRulesOutput, isLast bool) bool {
+			for _, rule := range page.SecurityGroupRules {
+				// Skip rules of groups created since the groups were listed
+				group, ok := groups[types.SafeString(rule.GroupId)]
+				if !ok {
+					continue
+				}
+				d.StreamListItem(ctx, &vpcSecurityGroupRulesRowData{
+					Group: group,
+					Rule:  rule,
+				})
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
 }
 
 //// HYDRATE FUNCTIONS
 
-func getSecurityGroupRuleTurbotData(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+func getSecurityGroupRule(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	logger.Trace("getSecurityGroupRule")
 	sgRule := h.Item.(*vpcSecurityGroupRulesRowData)
 
-	commonData, err := getCommonColumns(ctx, d, h)
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+
+	// get service
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get call
+	op, err := svc.DescribeSecurityGroupRules(&ec2.DescribeSecurityGroupRulesInput{
+		SecurityGroupRuleIds: []*string{sgRule.Rule.SecurityGroupRuleId},
+	})
+	if err != nil {
+		logger.Debug("getSecurityGroupRule__", "ERROR", err)
+		return nil, err
+	}
+	if len(op.SecurityGroupRules) == 0 {
+		return nil, nil
+	}
+	rule := op.SecurityGroupRules[0]
+
+	// The group name and VPC are only available from the security group itself
+	groups, err := svc.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{
+		GroupIds: []*string{rule.GroupId},
+	})
 	if err != nil {
+		logger.Debug("getSecurityGroupRule__", "ERROR", err)
 		return nil, err
 	}
+	if len(groups.SecurityGroups) == 0 {
+		return nil, nil
+	}
+
+	return &vpcSecurityGroupRulesRowData{
+		Group: groups.SecurityGroups[0],
+		Rule:  rule,
+	}, nil
+}
 
+func getSecurityGroupRuleTurbotAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getSecurityGroupRuleTurbotAkas")
+	sgRule := h.Item.(*vpcSecurityGroupRulesRowData)
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
 	commonColumnData := commonData.(*awsCommonColumnData)
 
-	// To create uninque aka
-	hashCode := sgRule.Type + "_" + *sgRule.Permission.IpProtocol
-	if sgRule.Permission.FromPort != nil {
-		hashCode = hashCode + "_" + types.IntToString(sgRule.Permission.FromPort) + "_" + types.IntToString(sgRule.Permission.ToPort)
+	// Get data for turbot defined properties
+	akas := []string{"arn:" + commonColumnData.Partition + ":ec2:" + commonColumnData.Region + ":" + *sgRule.Rule.GroupOwnerId + ":security-group-rule/" + *sgRule.Rule.SecurityGroupRuleId}
+
+	return akas, nil
+}
+
+func getSecurityGroupRulePairStaleStatus(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getSecurityGroupRulePairStaleStatus")
+	sgRule := h.Item.(*vpcSecurityGroupRulesRowData)
+	pair := sgRule.Rule.ReferencedGroupInfo
+
+	// Only rules referencing another security group can be stale; stale
+	// references are reported per VPC, so EC2-Classic groups are skipped
+	if pair == nil || sgRule.Group.VpcId == nil {
+		return nil, nil
 	}
 
-	if sgRule.IPRange != nil && sgRule.IPRange.CidrIp != nil {
-		hashCode = hashCode + "_" + *sgRule.IPRange.CidrIp
-	} else if sgRule.Ipv6Range != nil && sgRule.Ipv6Range.CidrIpv6 != nil {
-		hashCode = hashCode + "_" + *sgRule.Ipv6Range.CidrIpv6
-	} else if sgRule.UserIDGroupPair != nil && *sgRule.UserIDGroupPair.GroupId == *sgRule.Group.GroupId {
-		hashCode = hashCode + "_" + *sgRule.Group.GroupId
-	} else if sgRule.UserIDGroupPair != nil && *sgRule.UserIDGroupPair.GroupId == *sgRule.Group.GroupId {
-		hashCode = hashCode + "_" + *sgRule.UserIDGroupPair.GroupId
+	staleGroups, err := getVpcStaleSecurityGroups(ctx, d, *sgRule.Group.VpcId)
+	if err != nil {
+		return nil, err
 	}
 
-	// generate aka for the rule
-	akas := []string{"arn:" + commonColumnData.Partition + ":ec2:" + commonColumnData.Region + ":" + *sgRule.Group.OwnerId + ":security-group/" + *sgRule.Group.GroupId + ":" + hashCode}
+	for _, staleGroup := range staleGroups {
+		if *staleGroup.GroupId != *sgRule.Rule.GroupId {
+			continue
+		}
+		stalePermissions := staleGroup.StaleIpPermissions
+		if types.BoolValue(sgRule.Rule.IsEgress) {
+			stalePermissions = staleGroup.StaleIpPermissionsEgress
+		}
+		for _, permission := range stalePermissions {
+			if types.SafeString(permission.IpProtocol) != types.SafeString(sgRule.Rule.IpProtocol) ||
+				types.Int64Value(permission.FromPort) != types.Int64Value(sgRule.Rule.FromPort) ||
+				types.Int64Value(permission.ToPort) != types.Int64Value(sgRule.Rule.ToPort) {
+				continue
+			}
+			for _, stalePair := range permission.UserIdGroupPairs {
+				if types.SafeString(stalePair.GroupId) == types.SafeString(pair.GroupId) {
+					return true, nil
+				}
+			}
+		}
+	}
 
-	title := *sgRule.Group.GroupId + "_" + hashCode
+	return false, nil
+}
 
-	turbotData := map[string]interface{}{
-		"Akas":  akas,
-		"Title": title,
+// getVpcStaleSecurityGroups returns the security groups in the VPC with stale rules,
+// caching the result so that the lookup is made once per VPC rather than once per rule
+func getVpcStaleSecurityGroups(ctx context.Context, d *plugin.QueryData, vpcID string) ([]*ec2.StaleSecurityGroup, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
 	}
 
-	return turbotData, nil
+	cacheKey := "staleSecurityGroups-" + region + "-" + vpcID
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.([]*ec2.StaleSecurityGroup), nil
+	}
+
+	// get service
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	staleGroups := []*ec2.StaleSecurityGroup{}
+	err = svc.DescribeStaleSecurityGroupsPages(
+		&ec2.DescribeStaleSecurityGroupsInput{
+			VpcId: &vpcID,
+		},
+		func(page *ec2.DescribeStaleSecurityGroupsOutput, isLast bool) bool {
+			staleGroups = append(staleGroups, page.StaleSecurityGroupSet...)
+			return !isLast
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(cacheKey, staleGroups)
+	return staleGroups, nil
 }
 
-// custom struct for security group rule
-type vpcSecurityGroupRulesRowData struct {
-	Group           *ec2.SecurityGroup
-	Permission      *ec2.IpPermission
-	IPRange         *ec2.IpRange
-	Ipv6Range       *ec2.Ipv6Range
-	UserIDGroupPair *ec2.UserIdGroupPair
-	Type            string
+//// TRANSFORM FUNCTIONS
+
+func securityGroupRuleType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	sgRule := d.HydrateItem.(*vpcSecurityGroupRulesRowData)
+	if types.BoolValue(sgRule.Rule.IsEgress) {
+		return "egress", nil
+	}
+	return "ingress", nil
+}
+
+func securityGroupRulePortRange(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	rule := d.HydrateItem.(*vpcSecurityGroupRulesRowData).Rule
+
+	switch types.SafeString(rule.IpProtocol) {
+	case "icmp", "icmpv6", "1", "58":
+		// ports hold ICMP types and codes
+		return nil, nil
+	case "-1":
+		return "0-65535", nil
+	}
+
+	// tcp and udp report -1 (or no ports at all) when every port is allowed
+	if rule.FromPort == nil || *rule.FromPort == -1 {
+		return "0-65535", nil
+	}
+	return fmt.Sprintf("%d-%d", *rule.FromPort, *rule.ToPort), nil
 }
 
-// TODO - Get call
-// func getSecurityGroupRules(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
-// 	// get the required name field value from keyValues
-// 	sgGroup := h.Item.(*ec2.SecurityGroup)
-// 	defaultRegion := GetDefaultRegion()
-
-// 	// get service
-// 	svc, err := Ec2Service(ctx, d, defaultRegion)
-// 	if err != nil {
-// 		return nil, err
-// 	}
-
-// 	params := &ec2.DescribeSecurityGroupsInput{
-// 		GroupIds: []*string{sgGroup.GroupId},
-// 	}
-
-// 	items, err := svc.DescribeSecurityGroups(params)
-// 	if err != nil {
-// 		return nil, err
-// 	}
-
-// 	var rowSource []interface{}
-// 	if items.SecurityGroups != nil && len(items.SecurityGroups) > 0 {
-// 		if items.SecurityGroups[0].IpPermissions != nil {
-// 			for _, permission := range items.SecurityGroups[0].IpPermissions {
-// 				rowSource = append(rowSource, rowSourceFromIPPermission(items.SecurityGroups[0], permission, "ingress")...)
-// 			}
-// 		}
-
-// 		if items.SecurityGroups[0].IpPermissionsEgress != nil {
-// 			for _, permission := range items.SecurityGroups[0].IpPermissionsEgress {
-// 				rowSource = append(rowSource, rowSourceFromIPPermission(items.SecurityGroups[0], permission, "ingress")...)
-// 			}
-
-// 		}
-// 		return rowSource, nil
-// 	}
-
-// 	return []interface{}{}, nil
-// }
+func securityGroupRulePairGroupName(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	sgRule := d.HydrateItem.(*vpcSecurityGroupRulesRowData)
+	if sgRule.Rule.ReferencedGroupInfo == nil {
+		return nil, nil
+	}
+
+	// the rule only carries the referenced group ID, the name is taken from
+	// the matching pair in the group's own permissions
+	permissions := sgRule.Group.IpPermissions
+	if types.BoolValue(sgRule.Rule.IsEgress) {
+		permissions = sgRule.Group.IpPermissionsEgress
+	}
+	for _, permission := range permissions {
+		for _, pair := range permission.UserIdGroupPairs {
+			if types.SafeString(pair.GroupId) == types.SafeString(sgRule.Rule.ReferencedGroupInfo.GroupId) {
+				return pair.GroupName, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+func securityGroupRulePairIsPeered(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	sgRule := d.HydrateItem.(*vpcSecurityGroupRulesRowData)
+	pair := sgRule.Rule.ReferencedGroupInfo
+	if pair == nil {
+		return nil, nil
+	}
+
+	if pair.VpcPeeringConnectionId != nil {
+		return true, nil
+	}
+	return pair.VpcId != nil && sgRule.Group.VpcId != nil && *pair.VpcId != *sgRule.Group.VpcId, nil
+}
+
+func getSecurityGroupRuleTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	sgRule := d.HydrateItem.(*vpcSecurityGroupRulesRowData)
+	return ec2TagsToMap(sgRule.Rule.Tags)
+}
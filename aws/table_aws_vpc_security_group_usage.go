@@ -0,0 +1,275 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsVpcSecurityGroupUsage(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_vpc_security_group_usage",
+		Description: "AWS VPC Security Group Usage",
+		List: &plugin.ListConfig{
+			Hydrate: listVpcSecurityGroupUsages,
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "group_id",
+				Description: "The ID of the security group in use",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the resource using the security group, named after the table that describes it (aws_ec2_network_interface | aws_ec2_instance | aws_ec2_application_load_balancer | aws_ec2_network_load_balancer | aws_ec2_gateway_load_balancer | aws_ec2_classic_load_balancer | aws_rds_db_instance | aws_lambda_function)",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_id",
+				Description: "The ID or name of the resource using the security group",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_arn",
+				Description: "The Amazon Resource Name (ARN) of the resource using the security group",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vpc_id",
+				Description: "The ID of the VPC in which the resource is running",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(securityGroupUsageTitle),
+			},
+		}),
+	}
+}
+
+// custom struct for a single use of a security group by a resource
+type securityGroupUsage struct {
+	GroupId      *string
+	ResourceType string
+	ResourceId   *string
+	ResourceArn  *string
+	VpcId        *string
+}
+
+//// LIST FUNCTION
+
+func listVpcSecurityGroupUsages(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// TODO put me in helper function
+	var region string
+	matrixRegion := plugin.GetMatrixItem(ctx)[matrixKeyRegion]
+	if matrixRegion != nil {
+		region = matrixRegion.(string)
+	}
+	plugin.Logger(ctx).Trace("listVpcSecurityGroupUsages", "AWS_REGION", region)
+
+	commonData, err := getCommonColumns(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// every resource type that can have security groups attached is listed in turn
+	listFuncs := []func(context.Context, *plugin.QueryData, string, *awsCommonColumnData) error{
+		listSecurityGroupUsagesByNetworkInterfaces,
+		listSecurityGroupUsagesByInstances,
+		listSecurityGroupUsagesByLoadBalancers,
+		listSecurityGroupUsagesByClassicLoadBalancers,
+		listSecurityGroupUsagesByRDSDBInstances,
+		listSecurityGroupUsagesByLambdaFunctions,
+	}
+	for _, listFunc := range listFuncs {
+		if err := listFunc(ctx, d, region, commonColumnData); err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+func listSecurityGroupUsagesByNetworkInterfaces(ctx context.Context, d *plugin.QueryData, region string, commonColumnData *awsCommonColumnData) error {
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return err
+	}
+
+	return svc.DescribeNetworkInterfacesPages(
+		&ec2.DescribeNetworkInterfacesInput{},
+		func(page *ec2.DescribeNetworkInterfacesOutput, isLast bool) bool {
+			for _, networkInterface := range page.NetworkInterfaces {
+				arn := "arn:" + commonColumnData.Partition + ":ec2:" + region + ":" + commonColumnData.AccountId + ":network-interface/" + *networkInterface.NetworkInterfaceId
+				for _, group := range networkInterface.Groups {
+					d.StreamListItem(ctx, &securityGroupUsage{
+						GroupId:      group.GroupId,
+						ResourceType: "aws_ec2_network_interface",
+						ResourceId:   networkInterface.NetworkInterfaceId,
+						ResourceArn:  &arn,
+						VpcId:        networkInterface.VpcId,
+					})
+				}
+			}
+			return !isLast
+		},
+	)
+}
+
+func listSecurityGroupUsagesByInstances(ctx context.Context, d *plugin.QueryData, region string, commonColumnData *awsCommonColumnData) error {
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return err
+	}
+
+	return svc.DescribeInstancesPages(
+		&ec2.DescribeInstancesInput{},
+		func(page *ec2.DescribeInstancesOutput, isLast bool) bool {
+			for _, reservation := range page.Reservations {
+				for _, instance := range reservation.Instances {
+					arn := "arn:" + commonColumnData.Partition + ":ec2:" + region + ":" + commonColumnData.AccountId + ":instance/" + *instance.InstanceId
+					for _, group := range instance.SecurityGroups {
+						d.StreamListItem(ctx, &securityGroupUsage{
+							GroupId:      group.GroupId,
+							ResourceType: "aws_ec2_instance",
+							ResourceId:   instance.InstanceId,
+							ResourceArn:  &arn,
+							VpcId:        instance.VpcId,
+						})
+					}
+				}
+			}
+			return !isLast
+		},
+	)
+}
+
+func listSecurityGroupUsagesByLoadBalancers(ctx context.Context, d *plugin.QueryData, region string, _ *awsCommonColumnData) error {
+	svc, err := ELBv2Service(ctx, d, region)
+	if err != nil {
+		return err
+	}
+
+	return svc.DescribeLoadBalancersPages(
+		&elbv2.DescribeLoadBalancersInput{},
+		func(page *elbv2.DescribeLoadBalancersOutput, isLast bool) bool {
+			for _, loadBalancer := range page.LoadBalancers {
+				resourceType := "aws_ec2_" + strings.ToLower(*loadBalancer.Type) + "_load_balancer"
+				for _, groupID := range loadBalancer.SecurityGroups {
+					d.StreamListItem(ctx, &securityGroupUsage{
+						GroupId:      groupID,
+						ResourceType: resourceType,
+						ResourceId:   loadBalancer.LoadBalancerName,
+						ResourceArn:  loadBalancer.LoadBalancerArn,
+						VpcId:        loadBalancer.VpcId,
+					})
+				}
+			}
+			return !isLast
+		},
+	)
+}
+
+func listSecurityGroupUsagesByClassicLoadBalancers(ctx context.Context, d *plugin.QueryData, region string, commonColumnData *awsCommonColumnData) error {
+	svc, err := ELBService(ctx, d, region)
+	if err != nil {
+		return err
+	}
+
+	return svc.DescribeLoadBalancersPages(
+		&elb.DescribeLoadBalancersInput{},
+		func(page *elb.DescribeLoadBalancersOutput, isLast bool) bool {
+			for _, loadBalancer := range page.LoadBalancerDescriptions {
+				arn := "arn:" + commonColumnData.Partition + ":elasticloadbalancing:" + region + ":" + commonColumnData.AccountId + ":loadbalancer/" + *loadBalancer.LoadBalancerName
+				for _, groupID := range loadBalancer.SecurityGroups {
+					d.StreamListItem(ctx, &securityGroupUsage{
+						GroupId:      groupID,
+						ResourceType: "aws_ec2_classic_load_balancer",
+						ResourceId:   loadBalancer.LoadBalancerName,
+						ResourceArn:  &arn,
+						VpcId:        loadBalancer.VPCId,
+					})
+				}
+			}
+			return !isLast
+		},
+	)
+}
+
+func listSecurityGroupUsagesByRDSDBInstances(ctx context.Context, d *plugin.QueryData, region string, _ *awsCommonColumnData) error {
+	svc, err := RDSService(ctx, d, region)
+	if err != nil {
+		return err
+	}
+
+	return svc.DescribeDBInstancesPages(
+		&rds.DescribeDBInstancesInput{},
+		func(page *rds.DescribeDBInstancesOutput, isLast bool) bool {
+			for _, dbInstance := range page.DBInstances {
+				var vpcID *string
+				if dbInstance.DBSubnetGroup != nil {
+					vpcID = dbInstance.DBSubnetGroup.VpcId
+				}
+				for _, group := range dbInstance.VpcSecurityGroups {
+					d.StreamListItem(ctx, &securityGroupUsage{
+						GroupId:      group.VpcSecurityGroupId,
+						ResourceType: "aws_rds_db_instance",
+						ResourceId:   dbInstance.DBInstanceIdentifier,
+						ResourceArn:  dbInstance.DBInstanceArn,
+						VpcId:        vpcID,
+					})
+				}
+			}
+			return !isLast
+		},
+	)
+}
+
+func listSecurityGroupUsagesByLambdaFunctions(ctx context.Context, d *plugin.QueryData, region string, _ *awsCommonColumnData) error {
+	svc, err := LambdaService(ctx, d, region)
+	if err != nil {
+		return err
+	}
+
+	return svc.ListFunctionsPages(
+		&lambda.ListFunctionsInput{},
+		func(page *lambda.ListFunctionsOutput, isLast bool) bool {
+			for _, function := range page.Functions {
+				if function.VpcConfig == nil {
+					continue
+				}
+				for _, groupID := range function.VpcConfig.SecurityGroupIds {
+					d.StreamListItem(ctx, &securityGroupUsage{
+						GroupId:      groupID,
+						ResourceType: "aws_lambda_function",
+						ResourceId:   function.FunctionName,
+						ResourceArn:  function.FunctionArn,
+						VpcId:        function.VpcConfig.VpcId,
+					})
+				}
+			}
+			return !isLast
+		},
+	)
+}
+
+//// TRANSFORM FUNCTIONS
+
+func securityGroupUsageTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	usage := d.HydrateItem.(*securityGroupUsage)
+	return *usage.GroupId + "_" + *usage.ResourceId, nil
+}
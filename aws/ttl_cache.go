@@ -0,0 +1,132 @@
+package aws
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+)
+
+// ttlCache is a cache of values that expire after a fixed time. Unlike the
+// connection cache, which only lives for a single query, it is kept for the
+// lifetime of the plugin, so keys must include the connection they are for.
+type ttlCache struct {
+	mu        sync.Mutex
+	ttl       time.Duration
+	entries   map[string]ttlCacheEntry
+	creating  map[string]*ttlCacheCall
+	lastSweep time.Time
+}
+
+type ttlCacheEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+// ttlCacheCall is a create call in progress, which other callers for the
+// same key wait on
+type ttlCacheCall struct {
+	done  chan struct{}
+	value interface{}
+	err   error
+}
+
+func newTTLCache(ttl time.Duration) *ttlCache {
+	return &ttlCache{
+		ttl:       ttl,
+		entries:   map[string]ttlCacheEntry{},
+		creating:  map[string]*ttlCacheCall{},
+		lastSweep: time.Now(),
+	}
+}
+
+// Get returns the value for the key, if it is cached and has not expired.
+func (c *ttlCache) Get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.get(key)
+}
+
+// Set caches the value for the key.
+func (c *ttlCache) Set(key string, value interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.set(key, value)
+}
+
+// GetOrCreate returns the value for the key, calling create to make and cache
+// it if it is not cached. Concurrent callers for a missing key wait for the
+// first to create it, without blocking callers for other keys.
+func (c *ttlCache) GetOrCreate(key string, create func() (interface{}, error)) (interface{}, error) {
+	c.mu.Lock()
+	if value, ok := c.get(key); ok {
+		c.mu.Unlock()
+		return value, nil
+	}
+	if call, ok := c.creating[key]; ok {
+		c.mu.Unlock()
+		<-call.done
+		return call.value, call.err
+	}
+	call := &ttlCacheCall{done: make(chan struct{})}
+	c.creating[key] = call
+	c.mu.Unlock()
+
+	call.value, call.err = create()
+
+	c.mu.Lock()
+	delete(c.creating, key)
+	if call.err == nil {
+		c.set(key, call.value)
+	}
+	c.mu.Unlock()
+	close(call.done)
+
+	return call.value, call.err
+}
+
+func (c *ttlCache) get(key string) (interface{}, bool) {
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+// set caches the value, and drops expired entries at most once per ttl so
+// keys that are never read again do not build up
+func (c *ttlCache) set(key string, value interface{}) {
+	now := time.Now()
+	if now.Sub(c.lastSweep) > c.ttl {
+		for k, entry := range c.entries {
+			if now.After(entry.expires) {
+				delete(c.entries, k)
+			}
+		}
+		c.lastSweep = now
+	}
+	c.entries[key] = ttlCacheEntry{
+		value:   value,
+		expires: now.Add(c.ttl),
+	}
+}
+
+// pluginCacheKey prefixes the key with the connection and a hash of its
+// config, so a ttlCache entry is never used by another connection or after
+// the connection config changes. The config is hashed so credentials are not
+// kept in the keys.
+func pluginCacheKey(d *plugin.QueryData, key string) string {
+	if d.Connection == nil {
+		return key
+	}
+	config, _ := json.Marshal(GetConfig(d.Connection))
+	hash := sha256.Sum256(config)
+	return d.Connection.Name + "-" + hex.EncodeToString(hash[:]) + "-" + key
+}
@@ -0,0 +1,98 @@
+package aws
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTTLCacheExpiry(t *testing.T) {
+	cache := newTTLCache(50 * time.Millisecond)
+	cache.Set("bucket", "eu-west-1")
+
+	if value, ok := cache.Get("bucket"); !ok || value != "eu-west-1" {
+		t.Errorf("expected cached value eu-west-1, got %v", value)
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	if value, ok := cache.Get("bucket"); ok {
+		t.Errorf("expected value to have expired, got %v", value)
+	}
+}
+
+func TestTTLCacheGetOrCreate(t *testing.T) {
+	cache := newTTLCache(time.Hour)
+	calls := 0
+	create := func() (interface{}, error) {
+		calls++
+		return calls, nil
+	}
+
+	for i := 0; i < 3; i++ {
+		value, err := cache.GetOrCreate("client", create)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if value != 1 {
+			t.Errorf("expected the first created value, got %v", value)
+		}
+	}
+
+	if _, err := cache.GetOrCreate("failing", func() (interface{}, error) {
+		return nil, errors.New("no credentials")
+	}); err == nil {
+		t.Error("expected the create error to be returned")
+	}
+	if _, ok := cache.Get("failing"); ok {
+		t.Error("expected a failed create not to be cached")
+	}
+}
+
+func TestTTLCacheSweep(t *testing.T) {
+	cache := newTTLCache(50 * time.Millisecond)
+	cache.Set("old", 1)
+
+	time.Sleep(100 * time.Millisecond)
+	cache.Set("new", 2)
+
+	if _, ok := cache.entries["old"]; ok {
+		t.Error("expected the expired entry to be swept on set")
+	}
+	if len(cache.entries) != 1 {
+		t.Errorf("expected one entry, got %d", len(cache.entries))
+	}
+}
+
+func TestTTLCacheGetOrCreatePerKey(t *testing.T) {
+	cache := newTTLCache(time.Hour)
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	go cache.GetOrCreate("slow", func() (interface{}, error) {
+		close(started)
+		<-release
+		return "slow", nil
+	})
+	<-started
+
+	// A slow create must not block other keys
+	value, err := cache.GetOrCreate("fast", func() (interface{}, error) {
+		return "fast", nil
+	})
+	if err != nil || value != "fast" {
+		t.Errorf("expected fast, got %v, %v", value, err)
+	}
+
+	// Callers for the same key wait for the first create
+	done := make(chan interface{})
+	go func() {
+		value, _ := cache.GetOrCreate("slow", func() (interface{}, error) {
+			return "second", nil
+		})
+		done <- value
+	}()
+	close(release)
+	if value := <-done; value != "slow" {
+		t.Errorf("expected the first created value, got %v", value)
+	}
+}
@@ -0,0 +1,156 @@
+package aws
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"io/ioutil"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+)
+
+const userDataRedacted = "[REDACTED]"
+
+// userData is the decoded user data of an instance or launch template,
+// along with any secrets found in it when scanning is enabled
+type userData struct {
+	UserData *string
+	Secrets  []userDataSecret
+}
+
+// userDataSecret describes a possible secret found in user data. The value
+// itself is never returned.
+type userDataSecret struct {
+	Type string `json:"type"`
+	Line int    `json:"line"`
+}
+
+// userDataSecretPattern matches a secret. If the expression has a capture
+// group, only the first group is redacted; otherwise the whole match is.
+type userDataSecretPattern struct {
+	Type       string
+	Expression *regexp.Regexp
+}
+
+var userDataSecretPatterns = []userDataSecretPattern{
+	{"aws_access_key_id", regexp.MustCompile(`\b((?:AKIA|ASIA)[0-9A-Z]{16})\b`)},
+	{"aws_secret_access_key", regexp.MustCompile(`(?i)aws_?secret_?access_?key["']?\s*[:=]\s*["']?([A-Za-z0-9/+=]{40})`)},
+	{"private_key", regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----`)},
+	{"github_token", regexp.MustCompile(`\b(gh[pousr]_[A-Za-z0-9]{36,})\b`)},
+	{"slack_token", regexp.MustCompile(`\b(xox[abprs]-[A-Za-z0-9-]{10,})\b`)},
+	{"password", regexp.MustCompile(`(?i)(?:password|passwd|pwd|secret|api_?key|token)["']?\s*[:=]\s*["']?([^\s"']{4,})`)},
+}
+
+// gzipMagic is the header that starts gzip compressed data. cloud-init
+// accepts gzip compressed user data, which is common for larger scripts.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// getUserData decodes base64 encoded user data, and scans it for secrets if
+// scan_user_data_secrets is set in the connection config. Secrets are
+// redacted from the returned user data unless redact_user_data_secrets is false.
+// User data that is not text, even after decompression, is returned as null.
+func getUserData(d *plugin.QueryData, encoded *string) (*userData, error) {
+	if encoded == nil {
+		return &userData{}, nil
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(*encoded)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := decodeUserDataText(decoded)
+	if !ok {
+		return &userData{}, nil
+	}
+
+	awsConfig := GetConfig(d.Connection)
+	if awsConfig.ScanUserDataSecrets == nil || !*awsConfig.ScanUserDataSecrets {
+		return &userData{UserData: &value}, nil
+	}
+
+	redacted, secrets := scanUserDataSecrets(value)
+	if awsConfig.RedactUserDataSecrets == nil || *awsConfig.RedactUserDataSecrets {
+		value = redacted
+	}
+
+	return &userData{UserData: &value, Secrets: secrets}, nil
+}
+
+// decodeUserDataText returns the user data as text, decompressing it first if
+// it is gzip compressed. It returns false if the result is not valid UTF-8.
+func decodeUserDataText(data []byte) (string, bool) {
+	if bytes.HasPrefix(data, gzipMagic) {
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return "", false
+		}
+		defer reader.Close()
+		data, err = ioutil.ReadAll(reader)
+		if err != nil {
+			return "", false
+		}
+	}
+	if !utf8.Valid(data) {
+		return "", false
+	}
+	return string(data), true
+}
+
+// scanUserDataSecrets returns the user data with any secrets redacted, and the
+// type and line number of each secret found
+func scanUserDataSecrets(value string) (string, []userDataSecret) {
+	secrets := []userDataSecret{}
+	lines := strings.Split(value, "\n")
+	inPrivateKey := false
+
+	for i, line := range lines {
+		// The body of a private key spans several lines, up to the END marker
+		if inPrivateKey {
+			if strings.Contains(line, "-----END ") {
+				inPrivateKey = false
+			} else {
+				lines[i] = userDataRedacted
+			}
+			continue
+		}
+
+		for _, pattern := range userDataSecretPatterns {
+			var matches [][]int
+			for _, match := range pattern.Expression.FindAllStringSubmatchIndex(line, -1) {
+				// Use the capture group, if any, as the secret
+				if len(match) > 2 && match[2] >= 0 {
+					match = match[2:4]
+				}
+				// Skip values already redacted by an earlier pattern
+				if line[match[0]:match[1]] != userDataRedacted {
+					matches = append(matches, match[:2])
+				}
+			}
+			if matches == nil {
+				continue
+			}
+			secrets = append(secrets, userDataSecret{Type: pattern.Type, Line: i + 1})
+
+			if pattern.Type == "private_key" {
+				// A key on a single line, e.g. with escaped newlines, is redacted whole
+				if strings.Contains(line, "-----END ") {
+					line = userDataRedacted
+					break
+				}
+				inPrivateKey = true
+				continue
+			}
+
+			// Redact from the end of the line so earlier offsets stay valid
+			for j := len(matches) - 1; j >= 0; j-- {
+				line = line[:matches[j][0]] + userDataRedacted + line[matches[j][1]:]
+			}
+		}
+		lines[i] = line
+	}
+
+	return strings.Join(lines, "\n"), secrets
+}
@@ -10,6 +10,8 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
 )
 
@@ -42,7 +44,10 @@ func arnToTitle(_ context.Context, d *transform.TransformData) (interface{}, err
 }
 
 func convertTimestamp(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	epochTime := d.Value.(*int64)
+	epochTime, ok := d.Value.(*int64)
+	if !ok || epochTime == nil {
+		return nil, nil
+	}
 
 	timeInSec := math.Floor(float64(*epochTime) / 1000)
 	unixTimestamp := time.Unix(int64(timeInSec), 0)
@@ -72,54 +77,6 @@ func handleNilString(_ context.Context, d *transform.TransformData) (interface{}
 	return value, nil
 }
 
-func rowSourceFromIPPermission(group *ec2.SecurityGroup, permission *ec2.IpPermission, groupType string) []interface{} {
-	var rowSource []interface{}
-
-	// create 1 row per ip-range
-	if permission.IpRanges != nil {
-		for _, r := range permission.IpRanges {
-			rowSource = append(rowSource, &vpcSecurityGroupRulesRowData{
-				Group:           group,
-				Permission:      permission,
-				IPRange:         r,
-				Ipv6Range:       nil,
-				UserIDGroupPair: nil,
-				Type:            groupType,
-			})
-		}
-	}
-
-	// create 1 row per ipv6-range
-	if permission.Ipv6Ranges != nil {
-		for _, r := range permission.Ipv6Ranges {
-			rowSource = append(rowSource, &vpcSecurityGroupRulesRowData{
-				Group:           group,
-				Permission:      permission,
-				IPRange:         nil,
-				Ipv6Range:       r,
-				UserIDGroupPair: nil,
-				Type:            groupType,
-			})
-		}
-	}
-
-	// create 1 row per user id group pair
-	if permission.UserIdGroupPairs != nil {
-		for _, r := range permission.UserIdGroupPairs {
-			rowSource = append(rowSource, &vpcSecurityGroupRulesRowData{
-				Group:           group,
-				Permission:      permission,
-				IPRange:         nil,
-				Ipv6Range:       nil,
-				UserIDGroupPair: r,
-				Type:            groupType,
-			})
-		}
-	}
-
-	return rowSource
-}
-
 func resourceInterfaceDescription(key string) string {
 	switch key {
 	case "akas":
@@ -131,3 +88,107 @@ func resourceInterfaceDescription(key string) string {
 	}
 	return ""
 }
+
+// getQualsStringValue returns the value of a single '=' qual on a column that is
+// not a key column, so it can be pushed down to the API as an optional filter.
+// Postgres still applies the qual to the rows returned.
+func getQualsStringValue(d *plugin.QueryData, column string) *string {
+	quals, ok := d.QueryContext.Quals[column]
+	if !ok || len(quals.Quals) != 1 {
+		return nil
+	}
+	qual := quals.Quals[0]
+	if qual.GetStringValue() != "=" || qual.Value == nil {
+		return nil
+	}
+	if _, ok := qual.Value.Value.(*proto.QualValue_StringValue); !ok {
+		return nil
+	}
+	value := qual.Value.GetStringValue()
+	return &value
+}
+
+// getQualsInt64Value returns the value of a single '=' qual on an integer
+// column that is not a key column.
+func getQualsInt64Value(d *plugin.QueryData, column string) *int64 {
+	quals, ok := d.QueryContext.Quals[column]
+	if !ok || len(quals.Quals) != 1 {
+		return nil
+	}
+	qual := quals.Quals[0]
+	if qual.GetStringValue() != "=" || qual.Value == nil {
+		return nil
+	}
+	if _, ok := qual.Value.Value.(*proto.QualValue_Int64Value); !ok {
+		return nil
+	}
+	value := qual.Value.GetInt64Value()
+	return &value
+}
+
+// getQualsJsonbValue returns the JSON text of a single '=' qual on a JSON
+// column that is not a key column.
+func getQualsJsonbValue(d *plugin.QueryData, column string) *string {
+	quals, ok := d.QueryContext.Quals[column]
+	if !ok || len(quals.Quals) != 1 {
+		return nil
+	}
+	qual := quals.Quals[0]
+	if qual.GetStringValue() != "=" || qual.Value == nil {
+		return nil
+	}
+	if _, ok := qual.Value.Value.(*proto.QualValue_JsonbValue); !ok {
+		return nil
+	}
+	value := qual.Value.GetJsonbValue()
+	return &value
+}
+
+// getQualsTimeValue returns the value of an = qual on a timestamp column, or
+// nil if there is none. Other operators are left for Postgres to apply.
+func getQualsTimeValue(d *plugin.QueryData, column string) *time.Time {
+	quals, ok := d.QueryContext.Quals[column]
+	if !ok {
+		return nil
+	}
+
+	for _, qual := range quals.Quals {
+		ts := qual.GetValue().GetTimestampValue()
+		if ts == nil || qual.GetStringValue() != "=" {
+			continue
+		}
+		t := time.Unix(ts.Seconds, int64(ts.Nanos))
+		return &t
+	}
+	return nil
+}
+
+// getQualsTimeRange returns the start and end of the time range given by the
+// quals on a timestamp column. Either bound is nil if it is not constrained.
+// An = qual gives a range of one period from the timestamp, as APIs return
+// nothing when the start and end are the same.
+func getQualsTimeRange(d *plugin.QueryData, column string, period time.Duration) (start *time.Time, end *time.Time) {
+	quals, ok := d.QueryContext.Quals[column]
+	if !ok {
+		return nil, nil
+	}
+
+	for _, qual := range quals.Quals {
+		ts := qual.GetValue().GetTimestampValue()
+		if ts == nil {
+			continue
+		}
+		t := time.Unix(ts.Seconds, int64(ts.Nanos))
+
+		switch qual.GetStringValue() {
+		case ">", ">=":
+			start = &t
+		case "<", "<=":
+			end = &t
+		case "=":
+			e := t.Add(period)
+			start, end = &t, &e
+		}
+	}
+	return start, end
+}
@@ -0,0 +1,85 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+)
+
+func timeQualsQueryData(column string, operator string, t time.Time) *plugin.QueryData {
+	return &plugin.QueryData{
+		QueryContext: &proto.QueryContext{
+			Quals: map[string]*proto.Quals{
+				column: {
+					Quals: []*proto.Qual{
+						{
+							FieldName: column,
+							Operator:  &proto.Qual_StringValue{StringValue: operator},
+							Value: &proto.QualValue{
+								Value: &proto.QualValue_TimestampValue{
+									TimestampValue: &timestamp.Timestamp{Seconds: t.Unix()},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestGetQualsTimeValue(t *testing.T) {
+	at := time.Unix(1600000000, 0)
+
+	for _, operator := range []string{"=", ">", ">=", "<", "<="} {
+		value := getQualsTimeValue(timeQualsQueryData("end_time", operator, at), "end_time")
+		if operator == "=" {
+			if value == nil || !value.Equal(at) {
+				t.Errorf("%s: expected %v, got %v", operator, at, value)
+			}
+		} else if value != nil {
+			t.Errorf("%s: expected no value, got %v", operator, value)
+		}
+	}
+
+	if value := getQualsTimeValue(timeQualsQueryData("end_time", "=", at), "start_time"); value != nil {
+		t.Errorf("expected no value for another column, got %v", value)
+	}
+}
+
+func TestGetQualsTimeRange(t *testing.T) {
+	at := time.Unix(1600000000, 0)
+
+	cases := []struct {
+		operator string
+		start    *time.Time
+		end      *time.Time
+	}{
+		{"=", &at, timePtr(at.Add(time.Minute))},
+		{">", &at, nil},
+		{">=", &at, nil},
+		{"<", nil, &at},
+		{"<=", nil, &at},
+	}
+
+	for _, c := range cases {
+		start, end := getQualsTimeRange(timeQualsQueryData("timestamp", c.operator, at), "timestamp", time.Minute)
+		if !timePtrEqual(start, c.start) || !timePtrEqual(end, c.end) {
+			t.Errorf("%s: expected %v to %v, got %v to %v", c.operator, c.start, c.end, start, end)
+		}
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
+func timePtrEqual(a *time.Time, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
@@ -3,8 +3,9 @@ module github.com/turbot/steampipe-plugin-aws
 go 1.15
 
 require (
-	github.com/aws/aws-sdk-go v1.37.24
+	github.com/aws/aws-sdk-go v1.44.122
 	github.com/gocarina/gocsv v0.0.0-20201208093247-67c824bc04d4
+	github.com/golang/protobuf v1.4.3
 	github.com/turbot/go-kit v0.1.1
 	github.com/turbot/steampipe-plugin-sdk v0.2.3
 )